@@ -12,7 +12,9 @@ import (
 var (
 	// NewYear represents New Year's Day on 1-Jan
 	NewYear = &cal.Holiday{
+		ID:    "aa/new-year",
 		Name:  "New Year's Day",
+		Names: map[string]string{"en": "New Year's Day"},
 		Month: time.January,
 		Day:   1,
 		Func:  cal.CalcDayOfMonth,
@@ -20,7 +22,9 @@ var (
 
 	// Epiphany represents Epiphany on 6-Jan
 	Epiphany = &cal.Holiday{
+		ID:    "aa/epiphany",
 		Name:  "Epiphany",
+		Names: map[string]string{"en": "Epiphany"},
 		Month: time.January,
 		Day:   6,
 		Func:  cal.CalcDayOfMonth,
@@ -28,35 +32,45 @@ var (
 
 	// MaundyThursday represents Maundy Thursday - three days before Easter
 	MaundyThursday = &cal.Holiday{
+		ID:     "aa/maundy-thursday",
 		Name:   "Maundy Thursday",
+		Names:  map[string]string{"en": "Maundy Thursday"},
 		Offset: -3,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// GoodFriday represents Good Friday - two days before Easter
 	GoodFriday = &cal.Holiday{
+		ID:     "aa/good-friday",
 		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
 		Offset: -2,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// Easter represents the day of Easter (Sunday)
 	Easter = &cal.Holiday{
+		ID:     "aa/easter",
 		Name:   "Easter",
+		Names:  map[string]string{"en": "Easter"},
 		Offset: 0,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// EasterMonday represents Easter Monday - the day after Easter
 	EasterMonday = &cal.Holiday{
+		ID:     "aa/easter-monday",
 		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
 		Offset: 1,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// WorkersDay represents International Workers' Day on 1-May
 	WorkersDay = &cal.Holiday{
+		ID:    "aa/workers-day",
 		Name:  "International Workers' Day",
+		Names: map[string]string{"en": "International Workers' Day"},
 		Month: time.May,
 		Day:   1,
 		Func:  cal.CalcDayOfMonth,
@@ -64,35 +78,45 @@ var (
 
 	// AscensionDay represents Ascension Day on the 39th day after Easter
 	AscensionDay = &cal.Holiday{
+		ID:     "aa/ascension-day",
 		Name:   "Ascension Day",
+		Names:  map[string]string{"en": "Ascension Day"},
 		Offset: 39,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// Pentecost represents Pentecoast Sunday on the 49th day after Easter
 	Pentecost = &cal.Holiday{
+		ID:     "aa/pentecost",
 		Name:   "Pentecost",
+		Names:  map[string]string{"en": "Pentecost"},
 		Offset: 49,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// PentecostMonday represents Pentecost Monday on the day after Pentecost (50 days after Easter)
 	PentecostMonday = &cal.Holiday{
+		ID:     "aa/pentecost-monday",
 		Name:   "Pentecost Monday",
+		Names:  map[string]string{"en": "Pentecost Monday"},
 		Offset: 50,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// CorpusChristi represents Corpus Christi on the 60th day after Easter
 	CorpusChristi = &cal.Holiday{
+		ID:     "aa/corpus-christi",
 		Name:   "Corpus Christi",
+		Names:  map[string]string{"en": "Corpus Christi"},
 		Offset: 60,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// AssumptionOfMary represents Assumption of Mary on 15-Aug
 	AssumptionOfMary = &cal.Holiday{
+		ID:    "aa/assumption-of-mary",
 		Name:  "Assumption of Mary",
+		Names: map[string]string{"en": "Assumption of Mary"},
 		Month: time.August,
 		Day:   15,
 		Func:  cal.CalcDayOfMonth,
@@ -100,7 +124,9 @@ var (
 
 	// AllSaintsDay represents All Saints' Day on 1-Nov
 	AllSaintsDay = &cal.Holiday{
+		ID:    "aa/all-saints-day",
 		Name:  "All Saints' Day",
+		Names: map[string]string{"en": "All Saints' Day"},
 		Month: time.November,
 		Day:   1,
 		Func:  cal.CalcDayOfMonth,
@@ -108,7 +134,9 @@ var (
 
 	// ArmisticeDay represents Armistice Day on 11-Nov
 	ArmisticeDay = &cal.Holiday{
+		ID:    "aa/armistice-day",
 		Name:  "Armistice Day",
+		Names: map[string]string{"en": "Armistice Day"},
 		Month: time.November,
 		Day:   11,
 		Func:  cal.CalcDayOfMonth,
@@ -116,7 +144,9 @@ var (
 
 	// ImmaculateConception represents Immaculate Conception on 8-Dec
 	ImmaculateConception = &cal.Holiday{
+		ID:    "aa/immaculate-conception",
 		Name:  "Immaculate Conception",
+		Names: map[string]string{"en": "Immaculate Conception"},
 		Month: time.December,
 		Day:   8,
 		Func:  cal.CalcDayOfMonth,
@@ -124,7 +154,9 @@ var (
 
 	// ChristmasDay represents Christmas Day on 25-Dec
 	ChristmasDay = &cal.Holiday{
+		ID:    "aa/christmas-day",
 		Name:  "Christmas Day",
+		Names: map[string]string{"en": "Christmas Day"},
 		Month: time.December,
 		Day:   25,
 		Func:  cal.CalcDayOfMonth,
@@ -132,7 +164,9 @@ var (
 
 	// ChristmasDay2 represents the day after Christmas (Boxing Day / St. Stephen's Day) on 26-Dec
 	ChristmasDay2 = &cal.Holiday{
+		ID:    "aa/christmas-day-2",
 		Name:  "2nd Day of Christmas",
+		Names: map[string]string{"en": "2nd Day of Christmas"},
 		Month: time.December,
 		Day:   26,
 		Func:  cal.CalcDayOfMonth,
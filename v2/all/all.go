@@ -0,0 +1,67 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Package all registers the holiday definitions of every country package so
+// that calendars can be looked up by code at runtime:
+//
+//	import (
+//		"github.com/rickar/cal/v2"
+//		_ "github.com/rickar/cal/v2/all"
+//	)
+//
+//	c := cal.NewBusinessCalendarFor("DE-BY")
+//
+// Importing this package includes all holiday definitions in the final binary.
+// Import individual country packages instead to register only those
+// countries.
+package all
+
+import (
+	_ "github.com/rickar/cal/v2/ar"
+	_ "github.com/rickar/cal/v2/at"
+	_ "github.com/rickar/cal/v2/au"
+	_ "github.com/rickar/cal/v2/be"
+	_ "github.com/rickar/cal/v2/bg"
+	_ "github.com/rickar/cal/v2/br"
+	_ "github.com/rickar/cal/v2/ca"
+	_ "github.com/rickar/cal/v2/ch"
+	_ "github.com/rickar/cal/v2/cy"
+	_ "github.com/rickar/cal/v2/cz"
+	_ "github.com/rickar/cal/v2/de"
+	_ "github.com/rickar/cal/v2/dk"
+	_ "github.com/rickar/cal/v2/ecb"
+	_ "github.com/rickar/cal/v2/ee"
+	_ "github.com/rickar/cal/v2/es"
+	_ "github.com/rickar/cal/v2/fi"
+	_ "github.com/rickar/cal/v2/fr"
+	_ "github.com/rickar/cal/v2/gb"
+	_ "github.com/rickar/cal/v2/gr"
+	_ "github.com/rickar/cal/v2/hr"
+	_ "github.com/rickar/cal/v2/hu"
+	_ "github.com/rickar/cal/v2/ie"
+	_ "github.com/rickar/cal/v2/is"
+	_ "github.com/rickar/cal/v2/it"
+	_ "github.com/rickar/cal/v2/jp"
+	_ "github.com/rickar/cal/v2/ke"
+	_ "github.com/rickar/cal/v2/lt"
+	_ "github.com/rickar/cal/v2/lu"
+	_ "github.com/rickar/cal/v2/lv"
+	_ "github.com/rickar/cal/v2/mt"
+	_ "github.com/rickar/cal/v2/mw"
+	_ "github.com/rickar/cal/v2/mx"
+	_ "github.com/rickar/cal/v2/nc"
+	_ "github.com/rickar/cal/v2/nl"
+	_ "github.com/rickar/cal/v2/no"
+	_ "github.com/rickar/cal/v2/nz"
+	_ "github.com/rickar/cal/v2/pl"
+	_ "github.com/rickar/cal/v2/pt"
+	_ "github.com/rickar/cal/v2/ro"
+	_ "github.com/rickar/cal/v2/rs"
+	_ "github.com/rickar/cal/v2/ru"
+	_ "github.com/rickar/cal/v2/se"
+	_ "github.com/rickar/cal/v2/si"
+	_ "github.com/rickar/cal/v2/sk"
+	_ "github.com/rickar/cal/v2/th"
+	_ "github.com/rickar/cal/v2/ua"
+	_ "github.com/rickar/cal/v2/us"
+	_ "github.com/rickar/cal/v2/za"
+)
@@ -0,0 +1,245 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package all
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal/v2"
+)
+
+func TestRegistered(t *testing.T) {
+	countries := cal.Countries()
+	if len(countries) != 48 {
+		t.Errorf("got %d countries; want 48", len(countries))
+	}
+	for _, c := range countries {
+		if c.Name == "" || (len(c.Holidays) == 0 && len(c.Subdivisions) == 0) {
+			t.Errorf("%s: missing name or holidays", c.Code)
+		}
+		if cal.LookupCountry(c.Code) != c {
+			t.Errorf("%s: lookup by code failed", c.Code)
+		}
+		if c.Alpha3 != "" && cal.LookupCountry(c.Alpha3) != c {
+			t.Errorf("%s: lookup by alpha-3 code %s failed", c.Code, c.Alpha3)
+		}
+		for _, s := range c.Subdivisions {
+			if cal.LookupSubdivision(s.Code) != s {
+				t.Errorf("%s: lookup by subdivision code %s failed", c.Code, s.Code)
+			}
+		}
+	}
+
+	tests := []struct {
+		code string
+		date time.Time
+		want bool
+	}{
+		{"US", time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC), false},
+		{"USA", time.Date(2021, 7, 6, 12, 0, 0, 0, time.UTC), true},
+		{"DE", time.Date(2021, 1, 6, 12, 0, 0, 0, time.UTC), true},
+		{"DE-BY", time.Date(2021, 1, 6, 12, 0, 0, 0, time.UTC), false},
+		{"CH-ZH", time.Date(2021, 8, 2, 12, 0, 0, 0, time.UTC), true},
+		{"ch-zh", time.Date(2021, 8, 2, 12, 0, 0, 0, time.UTC), true},
+		{"AU-NSW", time.Date(2021, 1, 26, 12, 0, 0, 0, time.UTC), false},
+		{"ECB", time.Date(2021, 12, 24, 12, 0, 0, 0, time.UTC), true},
+	}
+	for _, test := range tests {
+		c := cal.NewBusinessCalendarFor(test.code)
+		if c == nil {
+			t.Errorf("%s: calendar not found", test.code)
+			continue
+		}
+		if got := c.IsWorkday(test.date); got != test.want {
+			t.Errorf("%s %s: got: %t; want: %t", test.code, test.date, got, test.want)
+		}
+	}
+}
+
+func TestHolidayIDs(t *testing.T) {
+	for _, c := range cal.Countries() {
+		prefix := strings.ToLower(c.Code) + "/"
+		ids := make(map[string]*cal.Holiday)
+		lists := [][]*cal.Holiday{c.Holidays}
+		for _, s := range c.Subdivisions {
+			lists = append(lists, s.Holidays)
+		}
+		for _, l := range lists {
+			for _, h := range l {
+				if !strings.HasPrefix(h.ID, prefix) || len(h.ID) == len(prefix) {
+					t.Errorf("%s: bad ID %q for %s", c.Code, h.ID, h.Name)
+				}
+				if other, ok := ids[h.ID]; ok && other != h {
+					t.Errorf("%s: duplicate ID %q", c.Code, h.ID)
+				}
+				ids[h.ID] = h
+				if cal.LookupHoliday(h.ID) != h {
+					t.Errorf("%s: lookup by ID %q failed", c.Code, h.ID)
+				}
+			}
+		}
+	}
+}
+
+func TestHolidayNames(t *testing.T) {
+	for _, c := range cal.Countries() {
+		// every holiday of a country should be named in the same languages
+		langs := make(map[string]bool)
+		var hols []*cal.Holiday
+		lists := [][]*cal.Holiday{c.Holidays}
+		for _, s := range c.Subdivisions {
+			lists = append(lists, s.Holidays)
+		}
+		for _, l := range lists {
+			for _, h := range l {
+				for lang := range h.Names {
+					langs[lang] = true
+				}
+				hols = append(hols, h)
+			}
+		}
+		for _, h := range hols {
+			for lang := range langs {
+				if _, ok := h.Names[lang]; !ok {
+					t.Errorf("%s: %s has no %q name", c.Code, h.ID, lang)
+				}
+			}
+		}
+	}
+}
+
+func TestHolidaySources(t *testing.T) {
+	// countries whose holidays have no checked legal reference yet
+	pending := map[string]bool{"CY": true, "DK": true, "FI": true, "GR": true, "NC": true, "TH": true}
+
+	// holidays set by a separate decree each year
+	decreed := map[string]bool{
+		"ar/tourist-bridge-day-1": true,
+		"ar/tourist-bridge-day-2": true,
+		"ar/tourist-bridge-day-3": true,
+	}
+
+	for _, c := range cal.Countries() {
+		if pending[c.Code] {
+			continue
+		}
+		lists := [][]*cal.Holiday{c.Holidays}
+		for _, s := range c.Subdivisions {
+			lists = append(lists, s.Holidays)
+		}
+		for _, l := range lists {
+			for _, h := range l {
+				if h.Source == "" && !decreed[h.ID] {
+					t.Errorf("%s: %s has no source", c.Code, h.ID)
+				}
+			}
+		}
+	}
+}
+
+func TestHolidaySubdivisions(t *testing.T) {
+	for _, c := range cal.Countries() {
+		if len(c.Subdivisions) == 0 {
+			continue
+		}
+		want := make(map[*cal.Holiday][]string)
+		for _, s := range c.Subdivisions {
+			for _, h := range s.Holidays {
+				want[h] = append(want[h], s.Code)
+			}
+		}
+		for h, codes := range want {
+			if len(codes) == len(c.Subdivisions) {
+				codes = nil
+			}
+			if !reflect.DeepEqual(h.Subdivisions, codes) {
+				t.Errorf("%s: got: %v; want: %v", h.ID, h.Subdivisions, codes)
+			}
+		}
+	}
+}
+
+func TestSubdivisions(t *testing.T) {
+	for _, c := range cal.Countries() {
+		seen := make(map[string]bool)
+		for _, s := range c.Subdivisions {
+			if !strings.HasPrefix(s.Code, c.Code+"-") || len(s.Code) == len(c.Code)+1 || seen[s.Code] || s.Name == "" || len(s.Holidays) == 0 {
+				t.Errorf("%s: invalid subdivision: %s, %s", c.Code, s.Code, s.Name)
+			}
+			seen[s.Code] = true
+		}
+	}
+
+	tests := []struct {
+		country string
+		n       int
+		code    string
+		date    time.Time // a holiday in the subdivision
+	}{
+		{"AU", 8, "au-nsw", time.Date(2021, 10, 4, 12, 0, 0, 0, time.UTC)},
+		{"CH", 26, "ch-zh", time.Date(2021, 4, 5, 12, 0, 0, 0, time.UTC)},
+		{"DE", 16, "de-by", time.Date(2021, 1, 6, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		c := cal.LookupCountry(test.country)
+		if len(c.Subdivisions) != test.n {
+			t.Errorf("%s: got %d subdivisions; want: %d", test.country, len(c.Subdivisions), test.n)
+		}
+		want := strings.ToUpper(test.code)
+		s := cal.FindSubdivision(c.Subdivisions, test.code)
+		if s == nil || s.Code != want {
+			t.Errorf("%s: bad lookup: %v", test.code, s)
+			continue
+		}
+		if hc := s.NewCalendar(); hc.Name != want || len(hc.Holidays) != len(s.Holidays) {
+			t.Errorf("%s: bad calendar: %s, %d holidays", test.code, hc.Name, len(hc.Holidays))
+		} else if act, _, _ := hc.IsHoliday(test.date); !act {
+			t.Errorf("%s: %s is not a holiday", test.code, test.date)
+		}
+		if bc := s.NewBusinessCalendar(); bc.Name != want || bc.IsWorkday(test.date) {
+			t.Errorf("%s: bad business calendar", test.code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// intentional rules that the validator can't tell from mistakes
+	allowed := map[string]cal.Problem{
+		"ar/tourist-bridge-day-1":                      cal.ProblemNoDate,        // only decreed for some years
+		"ar/tourist-bridge-day-2":                      cal.ProblemNoDate,        // only decreed for some years
+		"ar/tourist-bridge-day-3":                      cal.ProblemNoDate,        // only decreed for some years
+		"jp/the-emperors-birthday":                     cal.ProblemNoDate,        // no birthday holiday in 2019
+		"ca/national-day-for-truth-and-reconciliation": cal.ProblemObservedMonth, // Saturday moves to Monday in October
+		"mx/new-year":                                  cal.ProblemObservedMonth, // Saturday moves to Friday in December
+		"mx/labour-day":                                cal.ProblemObservedMonth, // Saturday moves to Friday in April
+		"us/new-year":                                  cal.ProblemObservedMonth, // Saturday moves to Friday in December
+
+		// only when the days either side are holidays
+		"jp/national-holiday-between-constitution-memorial-day-and-childrens-day":       cal.ProblemNoDate,
+		"jp/national-holiday-between-respect-for-the-aged-day-and-autumnal-equinox-day": cal.ProblemNoDate,
+	}
+
+	for _, c := range cal.Countries() {
+		lists := map[string][]*cal.Holiday{c.Code: c.Holidays}
+		for _, s := range c.Subdivisions {
+			lists[s.Code] = s.Holidays
+		}
+		for code, l := range lists {
+			for _, d := range cal.ValidateHolidays(1950, 2100, l...) {
+				if p, ok := allowed[d.Holiday.ID]; ok && p == d.Problem {
+					continue
+				}
+				if d.Problem == cal.ProblemSameDate {
+					// movable holidays fall on fixed ones in some years, such
+					// as Ascension Day on 1 May 2008
+					t.Logf("%s: %s (%d)", code, d, d.Year)
+					continue
+				}
+				t.Errorf("%s: %s (%d)", code, d, d.Year)
+			}
+		}
+	}
+}
@@ -9,27 +9,56 @@ import (
 )
 
 var (
+	// Movable holiday (feriado trasladable) rules from Ley 27.399:
+	//   Tuesdays and Wednesdays move to the previous Monday
+	//   Thursdays and Fridays move to the following Monday
+	movableAlt = []cal.AltDay{
+		{Day: time.Tuesday, Offset: -1},
+		{Day: time.Wednesday, Offset: -2},
+		{Day: time.Thursday, Offset: 4},
+		{Day: time.Friday, Offset: 3},
+	}
+
+	// Güemes Day on a Friday stays in place since the following Monday is
+	// Belgrano Day.
+	guemesAlt = []cal.AltDay{
+		{Day: time.Tuesday, Offset: -1},
+		{Day: time.Wednesday, Offset: -2},
+		{Day: time.Thursday, Offset: 4},
+	}
 
-	// Standard AR weekend substitution rules:
-	//   Saturdays move to Friday
-	//   Sundays move to Monday
-	weekendAlt = []cal.AltDay{
-		{Day: time.Saturday, Offset: -1},
-		{Day: time.Sunday, Offset: 1},
+	// Holidays for tourism purposes (feriados con fines turísticos) set by a
+	// decree for each year, as allowed by Ley 27.399.
+	touristBridgeDays = map[int][]monthDay{
+		2018: {{time.April, 30}, {time.December, 24}, {time.December, 31}},
+		2019: {{time.July, 8}, {time.August, 19}, {time.October, 14}},
+		2020: {{time.March, 23}, {time.July, 10}, {time.December, 7}},
+		2021: {{time.May, 24}, {time.October, 8}, {time.November, 22}},
+		2022: {{time.October, 7}, {time.November, 21}, {time.December, 9}},
+		2023: {{time.May, 26}, {time.June, 19}, {time.October, 13}},
+		2024: {{time.April, 1}, {time.June, 21}, {time.October, 11}},
+		2025: {{time.May, 2}, {time.August, 15}, {time.November, 21}},
+		2026: {{time.March, 23}, {time.July, 10}, {time.December, 7}},
 	}
 
 	// Argentinian New Year is January 1st
 	NewYear = &cal.Holiday{
-		Name:  "Año nuevo",
-		Type:  cal.ObservancePublic,
-		Month: time.January,
-		Day:   1,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/new-year",
+		Source: "Ley 27.399",
+		Name:   "Año nuevo",
+		Names:  map[string]string{"es": "Año nuevo", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.January,
+		Day:    1,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian Carnival Day 1 Fest is last February Monday
 	CarnivalDay1 = &cal.Holiday{
+		ID:     "ar/carnival-day-1",
+		Source: "Ley 27.399",
 		Name:   "Carnaval Día 1",
+		Names:  map[string]string{"es": "Carnaval Día 1", "en": "Carnival Day 1"},
 		Type:   cal.ObservancePublic,
 		Offset: -48,
 		Func:   cal.CalcEasterOffset,
@@ -37,7 +66,10 @@ var (
 
 	// Argentinian Carnival Day 2 Fest is last February Monday
 	CarnivalDay2 = &cal.Holiday{
+		ID:     "ar/carnival-day-2",
+		Source: "Ley 27.399",
 		Name:   "Carnaval Día 2",
+		Names:  map[string]string{"es": "Carnaval Día 2", "en": "Carnival Day 2"},
 		Type:   cal.ObservancePublic,
 		Offset: -47,
 		Func:   cal.CalcEasterOffset,
@@ -45,123 +77,192 @@ var (
 
 	// Argentinian conmemoration of last coup at 1976
 	TruethDay = &cal.Holiday{
-		Name:  "Día de la verdad y justicia",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/truth-and-justice-day",
+		Source: "Ley 27.399",
+		Name:   "Día de la verdad y justicia",
+		Names:  map[string]string{"es": "Día de la verdad y justicia", "en": "Day of Remembrance for Truth and Justice"},
+		Type:   cal.ObservancePublic,
+		Month:  time.March,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian conmemoration of Malvinas War Veterans
 	MalvinasVeterans = &cal.Holiday{
-		Name:  "Día de los Veteranos de la Guerra de Malvinas",
-		Type:  cal.ObservancePublic,
-		Month: time.April,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/malvinas-veterans",
+		Source: "Ley 27.399",
+		Name:   "Día de los Veteranos de la Guerra de Malvinas",
+		Names:  map[string]string{"es": "Día de los Veteranos de la Guerra de Malvinas", "en": "Malvinas Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.April,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian Holy Friday
 	EasternsDay = &cal.Holiday{
+		ID:     "ar/good-friday",
+		Source: "Ley 27.399",
 		Name:   "Viernes Santo",
+		Names:  map[string]string{"es": "Viernes Santo", "en": "Good Friday"},
 		Type:   cal.ObservancePublic,
-		Offset: -3,
+		Offset: -2,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// Argentinian Labor Day
 	LaborDay = &cal.Holiday{
-		Name:  "Día del trabajador",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   1,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/labor-day",
+		Source: "Ley 27.399",
+		Name:   "Día del trabajador",
+		Names:  map[string]string{"es": "Día del trabajador", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    1,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian conmemoration of popular revolution of May 1810
 	RevolutionDay = &cal.Holiday{
-		Name:  "Revolución de Mayo",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/revolution-day",
+		Source: "Ley 27.399",
+		Name:   "Revolución de Mayo",
+		Names:  map[string]string{"es": "Revolución de Mayo", "en": "May Revolution"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian commemoration of the passage to the immortality of General Martín Miguel de Güemes.
 	GuemesDay = &cal.Holiday{
-		Name:     "Aniversario paso a la inmortalidad del General Martín Miguel de Güemes",
-		Type:     cal.ObservancePublic,
-		Observed: weekendAlt,
-		Month:    time.June,
-		Day:      17,
-		Func:     cal.CalcDayOfMonth,
+		ID:        "ar/guemes-day",
+		Source:    "Ley 27.258; Ley 27.399",
+		Name:      "Aniversario paso a la inmortalidad del General Martín Miguel de Güemes",
+		Names:     map[string]string{"es": "Aniversario paso a la inmortalidad del General Martín Miguel de Güemes", "en": "Anniversary of the Death of General Martín Miguel de Güemes"},
+		Type:      cal.ObservancePublic,
+		Observed:  guemesAlt,
+		Month:     time.June,
+		Day:       17,
+		Func:      cal.CalcDayOfMonth,
+		StartYear: 2016,
 	}
 
 	// Argentinian commemoration of the passage to the immortality of General Manuel Belgrano.
 	BelgranoDay = &cal.Holiday{
-		Name:     "Aniversario paso a la inmortalidad del General Juan Manuel Belgrano",
-		Type:     cal.ObservancePublic,
-		Observed: weekendAlt,
-		Month:    time.June,
-		Day:      20,
-		Func:     cal.CalcDayOfMonth,
+		ID:     "ar/belgrano-day",
+		Source: "Ley 27.399",
+		Name:   "Aniversario paso a la inmortalidad del General Juan Manuel Belgrano",
+		Names:  map[string]string{"es": "Aniversario paso a la inmortalidad del General Juan Manuel Belgrano", "en": "Anniversary of the Death of General Manuel Belgrano"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    20,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian Independece Day
 	IndependenceDay = &cal.Holiday{
-		Name:  "Día de la independencia",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   9,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/independence-day",
+		Source: "Ley 27.399",
+		Name:   "Día de la independencia",
+		Names:  map[string]string{"es": "Día de la independencia", "en": "Independence Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    9,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Argentinian commemoration of the passage to the immortality of General José de San Martín.
 	SanMartinDay = &cal.Holiday{
-		Name:     "Aniversario paso a la inmortalidad del General José de San Martín",
-		Type:     cal.ObservancePublic,
-		Observed: weekendAlt,
-		Month:    time.August,
-		Day:      17,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "ar/san-martin-day",
+		Source:       "Ley 27.399; Decreto 1584/2010 (2011 to 2016)",
+		Name:         "Aniversario paso a la inmortalidad del General José de San Martín",
+		Names:        map[string]string{"es": "Aniversario paso a la inmortalidad del General José de San Martín", "en": "Anniversary of the Death of General José de San Martín"},
+		Type:         cal.ObservancePublic,
+		Month:        time.August,
+		Day:          17,
+		Func:         cal.CalcDayOfMonth,
+		ObservedFunc: movable(3),
 	}
 
 	// Argentinian Respect for Cultural Diversity Day
 	DiversityDay = &cal.Holiday{
-		Name:     "Día del respeto a la diversidad cultural",
-		Type:     cal.ObservancePublic,
-		Observed: weekendAlt,
-		Month:    time.October,
-		Day:      12,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "ar/diversity-day",
+		Source:       "Ley 27.399; Decreto 1584/2010 (2011 to 2016)",
+		Name:         "Día del respeto a la diversidad cultural",
+		Names:        map[string]string{"es": "Día del respeto a la diversidad cultural", "en": "Day of Respect for Cultural Diversity"},
+		Type:         cal.ObservancePublic,
+		Month:        time.October,
+		Day:          12,
+		Func:         cal.CalcDayOfMonth,
+		ObservedFunc: movable(2),
 	}
 
 	// Argentinian National Sovereignty Day
 	SovereigntyDay = &cal.Holiday{
-		Name:     "Día de la Soberanía Nacional",
-		Type:     cal.ObservancePublic,
-		Observed: weekendAlt,
-		Month:    time.November,
-		Day:      20,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "ar/sovereignty-day",
+		Source:       "Ley 27.399; Decreto 1584/2010 (2011 to 2016)",
+		Name:         "Día de la Soberanía Nacional",
+		Names:        map[string]string{"es": "Día de la Soberanía Nacional", "en": "National Sovereignty Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.November,
+		Day:          20,
+		Func:         cal.CalcDayOfMonth,
+		ObservedFunc: movable(4),
 	}
 
 	// Argentinian commemoration of the Immaculate Conception of Mary
 	VirgenDay = &cal.Holiday{
-		Name:  "Día de la virgen María",
+		ID:     "ar/virgen-day",
+		Source: "Ley 27.399",
+		Name:   "Día de la virgen María",
+		Names:  map[string]string{"es": "Día de la virgen María", "en": "Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
+	}
+
+	// TouristBridgeDay1 represents the first holiday for tourism purposes
+	// decreed for the year
+	TouristBridgeDay1 = &cal.Holiday{
+		ID:    "ar/tourist-bridge-day-1",
+		Name:  "Feriado con fines turísticos",
+		Names: map[string]string{"es": "Feriado con fines turísticos", "en": "Bridge Holiday"},
+		Type:  cal.ObservancePublic,
+		Func:  touristBridgeDay(1),
+	}
+
+	// TouristBridgeDay2 represents the second holiday for tourism purposes
+	// decreed for the year
+	TouristBridgeDay2 = &cal.Holiday{
+		ID:    "ar/tourist-bridge-day-2",
+		Name:  "Feriado con fines turísticos",
+		Names: map[string]string{"es": "Feriado con fines turísticos", "en": "Bridge Holiday"},
 		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		Func:  touristBridgeDay(2),
+	}
+
+	// TouristBridgeDay3 represents the third holiday for tourism purposes
+	// decreed for the year
+	TouristBridgeDay3 = &cal.Holiday{
+		ID:    "ar/tourist-bridge-day-3",
+		Name:  "Feriado con fines turísticos",
+		Names: map[string]string{"es": "Feriado con fines turísticos", "en": "Bridge Holiday"},
+		Type:  cal.ObservancePublic,
+		Func:  touristBridgeDay(3),
 	}
 
 	// Argentinian Christmas Day
 	ChristmasDay = &cal.Holiday{
-		Name:  "Navidad",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ar/christmas-day",
+		Source: "Ley 27.399",
+		Name:   "Navidad",
+		Names:  map[string]string{"es": "Navidad", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -182,5 +283,55 @@ var (
 		SovereigntyDay,
 		VirgenDay,
 		ChristmasDay,
+		TouristBridgeDay1,
+		TouristBridgeDay2,
+		TouristBridgeDay3,
 	}
 )
+
+// movable returns the observed date calculation of a movable holiday (feriado
+// trasladable) observed on the nth Monday of its month from 2011 to 2016
+// (Decreto 1584/2010). Otherwise, the movableAlt rules from Ley 27.399 apply.
+func movable(n int) cal.ObservedFn {
+	return func(_ *cal.Holiday, actual time.Time) time.Time {
+		year := actual.Year()
+		if year >= 2011 && year <= 2016 {
+			return cal.DayStart(cal.WeekdayNIn(year, actual.Month(), time.Monday, n, actual.Location()))
+		}
+
+		day := actual.Weekday()
+		for _, o := range movableAlt {
+			if o.Day == day {
+				return actual.AddDate(0, 0, o.Offset)
+			}
+		}
+		return actual
+	}
+}
+
+// touristBridgeDay returns the calculation of the nth holiday for tourism
+// purposes decreed for each year.
+func touristBridgeDay(n int) cal.HolidayFn {
+	return func(_ *cal.Holiday, year int, loc *time.Location) time.Time {
+		days := touristBridgeDays[year]
+		if n > len(days) {
+			return time.Time{}
+		}
+		day := days[n-1]
+		return time.Date(year, day.month, day.day, 0, 0, 0, 0, loc)
+	}
+}
+
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "AR",
+		Alpha3:   "ARG",
+		Name:     "Argentina",
+		Holidays: Holidays,
+	})
+}
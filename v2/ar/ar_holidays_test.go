@@ -71,15 +71,15 @@ func TestHolidays(t *testing.T) {
 		{MalvinasVeterans, 2022, d(2022, 4, 2), d(2022, 4, 2)},
 		{MalvinasVeterans, 2023, d(2023, 4, 2), d(2023, 4, 2)},
 
-		{EasternsDay, 2015, d(2015, 4, 2), d(2015, 4, 2)},
-		{EasternsDay, 2016, d(2016, 3, 24), d(2016, 3, 24)},
-		{EasternsDay, 2017, d(2017, 4, 13), d(2017, 4, 13)},
-		{EasternsDay, 2018, d(2018, 3, 29), d(2018, 3, 29)},
-		{EasternsDay, 2019, d(2019, 4, 18), d(2019, 4, 18)},
-		{EasternsDay, 2020, d(2020, 4, 9), d(2020, 4, 9)},
-		{EasternsDay, 2021, d(2021, 4, 1), d(2021, 4, 1)},
-		{EasternsDay, 2022, d(2022, 4, 14), d(2022, 4, 14)},
-		{EasternsDay, 2023, d(2023, 4, 6), d(2023, 4, 6)},
+		{EasternsDay, 2015, d(2015, 4, 3), d(2015, 4, 3)},
+		{EasternsDay, 2016, d(2016, 3, 25), d(2016, 3, 25)},
+		{EasternsDay, 2017, d(2017, 4, 14), d(2017, 4, 14)},
+		{EasternsDay, 2018, d(2018, 3, 30), d(2018, 3, 30)},
+		{EasternsDay, 2019, d(2019, 4, 19), d(2019, 4, 19)},
+		{EasternsDay, 2020, d(2020, 4, 10), d(2020, 4, 10)},
+		{EasternsDay, 2021, d(2021, 4, 2), d(2021, 4, 2)},
+		{EasternsDay, 2022, d(2022, 4, 15), d(2022, 4, 15)},
+		{EasternsDay, 2023, d(2023, 4, 7), d(2023, 4, 7)},
 
 		{LaborDay, 2015, d(2015, 5, 1), d(2015, 5, 1)},
 		{LaborDay, 2016, d(2016, 5, 1), d(2016, 5, 1)},
@@ -101,23 +101,23 @@ func TestHolidays(t *testing.T) {
 		{RevolutionDay, 2022, d(2022, 5, 25), d(2022, 5, 25)},
 		{RevolutionDay, 2023, d(2023, 5, 25), d(2023, 5, 25)},
 
-		{GuemesDay, 2015, d(2015, 6, 17), d(2015, 6, 17)},
+		{GuemesDay, 2015, time.Time{}, time.Time{}},
 		{GuemesDay, 2016, d(2016, 6, 17), d(2016, 6, 17)},
-		{GuemesDay, 2017, d(2017, 6, 17), d(2017, 6, 16)},
-		{GuemesDay, 2018, d(2018, 6, 17), d(2018, 6, 18)},
+		{GuemesDay, 2017, d(2017, 6, 17), d(2017, 6, 17)},
+		{GuemesDay, 2018, d(2018, 6, 17), d(2018, 6, 17)},
 		{GuemesDay, 2019, d(2019, 6, 17), d(2019, 6, 17)},
-		{GuemesDay, 2020, d(2020, 6, 17), d(2020, 6, 17)},
-		{GuemesDay, 2021, d(2021, 6, 17), d(2021, 6, 17)},
+		{GuemesDay, 2020, d(2020, 6, 17), d(2020, 6, 15)},
+		{GuemesDay, 2021, d(2021, 6, 17), d(2021, 6, 21)},
 		{GuemesDay, 2022, d(2022, 6, 17), d(2022, 6, 17)},
-		{GuemesDay, 2023, d(2023, 6, 17), d(2023, 6, 16)},
+		{GuemesDay, 2023, d(2023, 6, 17), d(2023, 6, 17)},
 
-		{BelgranoDay, 2015, d(2015, 6, 20), d(2015, 6, 19)},
+		{BelgranoDay, 2015, d(2015, 6, 20), d(2015, 6, 20)},
 		{BelgranoDay, 2016, d(2016, 6, 20), d(2016, 6, 20)},
 		{BelgranoDay, 2017, d(2017, 6, 20), d(2017, 6, 20)},
 		{BelgranoDay, 2018, d(2018, 6, 20), d(2018, 6, 20)},
 		{BelgranoDay, 2019, d(2019, 6, 20), d(2019, 6, 20)},
-		{BelgranoDay, 2020, d(2020, 6, 20), d(2020, 6, 19)},
-		{BelgranoDay, 2021, d(2021, 6, 20), d(2021, 6, 21)},
+		{BelgranoDay, 2020, d(2020, 6, 20), d(2020, 6, 20)},
+		{BelgranoDay, 2021, d(2021, 6, 20), d(2021, 6, 20)},
 		{BelgranoDay, 2022, d(2022, 6, 20), d(2022, 6, 20)},
 		{BelgranoDay, 2023, d(2023, 6, 20), d(2023, 6, 20)},
 
@@ -132,33 +132,33 @@ func TestHolidays(t *testing.T) {
 		{IndependenceDay, 2023, d(2023, 7, 9), d(2023, 7, 9)},
 
 		{SanMartinDay, 2015, d(2015, 8, 17), d(2015, 8, 17)},
-		{SanMartinDay, 2016, d(2016, 8, 17), d(2016, 8, 17)},
-		{SanMartinDay, 2017, d(2017, 8, 17), d(2017, 8, 17)},
-		{SanMartinDay, 2018, d(2018, 8, 17), d(2018, 8, 17)},
-		{SanMartinDay, 2019, d(2019, 8, 17), d(2019, 8, 16)},
+		{SanMartinDay, 2016, d(2016, 8, 17), d(2016, 8, 15)},
+		{SanMartinDay, 2017, d(2017, 8, 17), d(2017, 8, 21)},
+		{SanMartinDay, 2018, d(2018, 8, 17), d(2018, 8, 20)},
+		{SanMartinDay, 2019, d(2019, 8, 17), d(2019, 8, 17)},
 		{SanMartinDay, 2020, d(2020, 8, 17), d(2020, 8, 17)},
-		{SanMartinDay, 2021, d(2021, 8, 17), d(2021, 8, 17)},
-		{SanMartinDay, 2022, d(2022, 8, 17), d(2022, 8, 17)},
-		{SanMartinDay, 2023, d(2023, 8, 17), d(2023, 8, 17)},
+		{SanMartinDay, 2021, d(2021, 8, 17), d(2021, 8, 16)},
+		{SanMartinDay, 2022, d(2022, 8, 17), d(2022, 8, 15)},
+		{SanMartinDay, 2023, d(2023, 8, 17), d(2023, 8, 21)},
 
 		{DiversityDay, 2015, d(2015, 10, 12), d(2015, 10, 12)},
-		{DiversityDay, 2016, d(2016, 10, 12), d(2016, 10, 12)},
-		{DiversityDay, 2017, d(2017, 10, 12), d(2017, 10, 12)},
-		{DiversityDay, 2018, d(2018, 10, 12), d(2018, 10, 12)},
-		{DiversityDay, 2019, d(2019, 10, 12), d(2019, 10, 11)},
+		{DiversityDay, 2016, d(2016, 10, 12), d(2016, 10, 10)},
+		{DiversityDay, 2017, d(2017, 10, 12), d(2017, 10, 16)},
+		{DiversityDay, 2018, d(2018, 10, 12), d(2018, 10, 15)},
+		{DiversityDay, 2019, d(2019, 10, 12), d(2019, 10, 12)},
 		{DiversityDay, 2020, d(2020, 10, 12), d(2020, 10, 12)},
-		{DiversityDay, 2021, d(2021, 10, 12), d(2021, 10, 12)},
-		{DiversityDay, 2022, d(2022, 10, 12), d(2022, 10, 12)},
-		{DiversityDay, 2023, d(2023, 10, 12), d(2023, 10, 12)},
+		{DiversityDay, 2021, d(2021, 10, 12), d(2021, 10, 11)},
+		{DiversityDay, 2022, d(2022, 10, 12), d(2022, 10, 10)},
+		{DiversityDay, 2023, d(2023, 10, 12), d(2023, 10, 16)},
 
-		{SovereigntyDay, 2015, d(2015, 11, 20), d(2015, 11, 20)},
-		{SovereigntyDay, 2016, d(2016, 11, 20), d(2016, 11, 21)},
+		{SovereigntyDay, 2015, d(2015, 11, 20), d(2015, 11, 23)},
+		{SovereigntyDay, 2016, d(2016, 11, 20), d(2016, 11, 28)},
 		{SovereigntyDay, 2017, d(2017, 11, 20), d(2017, 11, 20)},
-		{SovereigntyDay, 2018, d(2018, 11, 20), d(2018, 11, 20)},
-		{SovereigntyDay, 2019, d(2019, 11, 20), d(2019, 11, 20)},
-		{SovereigntyDay, 2020, d(2020, 11, 20), d(2020, 11, 20)},
-		{SovereigntyDay, 2021, d(2021, 11, 20), d(2021, 11, 19)},
-		{SovereigntyDay, 2022, d(2022, 11, 20), d(2022, 11, 21)},
+		{SovereigntyDay, 2018, d(2018, 11, 20), d(2018, 11, 19)},
+		{SovereigntyDay, 2019, d(2019, 11, 20), d(2019, 11, 18)},
+		{SovereigntyDay, 2020, d(2020, 11, 20), d(2020, 11, 23)},
+		{SovereigntyDay, 2021, d(2021, 11, 20), d(2021, 11, 20)},
+		{SovereigntyDay, 2022, d(2022, 11, 20), d(2022, 11, 20)},
 		{SovereigntyDay, 2023, d(2023, 11, 20), d(2023, 11, 20)},
 
 		{VirgenDay, 2015, d(2015, 12, 8), d(2015, 12, 8)},
@@ -180,6 +180,22 @@ func TestHolidays(t *testing.T) {
 		{ChristmasDay, 2021, d(2021, 12, 25), d(2021, 12, 25)},
 		{ChristmasDay, 2022, d(2022, 12, 25), d(2022, 12, 25)},
 		{ChristmasDay, 2023, d(2023, 12, 25), d(2023, 12, 25)},
+
+		{GuemesDay, 2025, d(2025, 6, 17), d(2025, 6, 16)},
+		{SanMartinDay, 2025, d(2025, 8, 17), d(2025, 8, 17)},
+		{DiversityDay, 2025, d(2025, 10, 12), d(2025, 10, 12)},
+		{SovereigntyDay, 2025, d(2025, 11, 20), d(2025, 11, 24)},
+
+		{TouristBridgeDay1, 2017, time.Time{}, time.Time{}},
+		{TouristBridgeDay1, 2018, d(2018, 4, 30), d(2018, 4, 30)},
+		{TouristBridgeDay2, 2018, d(2018, 12, 24), d(2018, 12, 24)},
+		{TouristBridgeDay3, 2018, d(2018, 12, 31), d(2018, 12, 31)},
+		{TouristBridgeDay1, 2023, d(2023, 5, 26), d(2023, 5, 26)},
+		{TouristBridgeDay2, 2023, d(2023, 6, 19), d(2023, 6, 19)},
+		{TouristBridgeDay3, 2023, d(2023, 10, 13), d(2023, 10, 13)},
+		{TouristBridgeDay1, 2025, d(2025, 5, 2), d(2025, 5, 2)},
+		{TouristBridgeDay2, 2025, d(2025, 8, 15), d(2025, 8, 15)},
+		{TouristBridgeDay3, 2025, d(2025, 11, 21), d(2025, 11, 21)},
 	}
 
 	for _, test := range tests {
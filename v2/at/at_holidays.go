@@ -12,49 +12,124 @@ import (
 
 var (
 	// Neujahr represents New Year's Day on 1-Jan
-	Neujahr = aa.NewYear.Clone(&cal.Holiday{Name: "Neujahrstag", Type: cal.ObservancePublic})
+	Neujahr = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "at/neujahr",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Neujahrstag",
+		Names:  map[string]string{"de": "Neujahrstag", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// HeiligeDreiKoenige represents Epiphany on 6-Jan
-	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{Name: "Heilige Drei Könige", Type: cal.ObservancePublic})
+	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "at/heilige-drei-koenige",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Heilige Drei Könige",
+		Names:  map[string]string{"de": "Heilige Drei Könige", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Ostermontag represents Easter Monday on the day after Easter
-	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Ostermontag", Type: cal.ObservancePublic})
+	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "at/ostermontag",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Ostermontag",
+		Names:  map[string]string{"de": "Ostermontag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TagderArbeit represents Labor Day on the first Monday in May
-	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{Name: "Tag der Arbeit", Type: cal.ObservancePublic})
+	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "at/tag-der-arbeit",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Tag der Arbeit",
+		Names:  map[string]string{"de": "Tag der Arbeit", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristiHimmelfahrt represents Ascension Day on the 39th day after Easter
-	ChristiHimmelfahrt = aa.AscensionDay.Clone(&cal.Holiday{Name: "Christi Himmelfahrt", Type: cal.ObservancePublic})
+	ChristiHimmelfahrt = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "at/christi-himmelfahrt",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Christi Himmelfahrt",
+		Names:  map[string]string{"de": "Christi Himmelfahrt", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pfingstmontag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Pfingstmontag", Type: cal.ObservancePublic})
+	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "at/pfingstmontag",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Pfingstmontag",
+		Names:  map[string]string{"de": "Pfingstmontag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Fronleichnam represents Corpus Christi on the 60th day after Easter
-	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{Name: "Fronleichnam", Type: cal.ObservancePublic})
+	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:     "at/fronleichnam",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Fronleichnam",
+		Names:  map[string]string{"de": "Fronleichnam", "en": "Corpus Christi"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// MariaHimmelfahrt represents Assumption of Mary on 15-Aug
-	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Mariä Himmelfahrt", Type: cal.ObservancePublic})
+	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "at/maria-himmelfahrt",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Mariä Himmelfahrt",
+		Names:  map[string]string{"de": "Mariä Himmelfahrt", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Nationalfeiertag represents National Day on 26-Oct
 	Nationalfeiertag = &cal.Holiday{
-		Name:  "Nationalfeiertag",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   26,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "at/nationalfeiertag",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Nationalfeiertag",
+		Names:  map[string]string{"de": "Nationalfeiertag", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    26,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Allerheiligen represents All Saints' Day on 1-Nov
-	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Allerheiligen", Type: cal.ObservancePublic})
+	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "at/allerheiligen",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Allerheiligen",
+		Names:  map[string]string{"de": "Allerheiligen", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// MariaEmpfaengnis represents Immaculate Conception on 8-Dec
-	MariaEmpfaengnis = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Mariä Empfängnis", Type: cal.ObservancePublic})
+	MariaEmpfaengnis = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:     "at/maria-empfaengnis",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Mariä Empfängnis",
+		Names:  map[string]string{"de": "Mariä Empfängnis", "en": "Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Christtag represents Christmas Day on 25-Dec
-	Christtag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christtag", Type: cal.ObservancePublic})
+	Christtag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "at/christtag",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Christtag",
+		Names:  map[string]string{"de": "Christtag", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Stefanitag represents St. Stephen's Day on 26-Dec
-	Stefanitag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Stefanitag", Type: cal.ObservancePublic})
+	Stefanitag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "at/stefanitag",
+		Source: "Arbeitsruhegesetz § 7 (BGBl. Nr. 144/1983)",
+		Name:   "Stefanitag",
+		Names:  map[string]string{"de": "Stefanitag", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -73,3 +148,12 @@ var (
 		Stefanitag,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "AT",
+		Alpha3:   "AUT",
+		Name:     "Austria",
+		Holidays: Holidays,
+	})
+}
@@ -20,11 +20,21 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "au/new-year",
+		Source:   "Fair Work Act 2009 (Cth) s 115",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// AustraliaDay represents Australia Day on 26-Jan
 	AustraliaDay = &cal.Holiday{
+		ID:       "au/australia-day",
+		Source:   "Fair Work Act 2009 (Cth) s 115",
 		Name:     "Australia Day",
+		Names:    map[string]string{"en": "Australia Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.January,
 		Day:      26,
@@ -33,213 +43,353 @@ var (
 	}
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "au/good-friday",
+		Source: "Fair Work Act 2009 (Cth) s 115",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterSaturday represents the day before Easter, which falls on a Saturday.
 	EasterSaturday = &cal.Holiday{
-		Name:   "Easter Saturday",
-		Offset: -1,
-		Func:   cal.CalcEasterOffset,
+		ID:           "au/easter-saturday",
+		Source:       "State and territory public holiday acts",
+		Subdivisions: []string{"AU-ACT", "AU-NSW", "AU-NT", "AU-QLD", "AU-SA", "AU-VIC"},
+		Name:         "Easter Saturday",
+		Names:        map[string]string{"en": "Easter Saturday"},
+		Offset:       -1,
+		Func:         cal.CalcEasterOffset,
 	}
 
 	// EasterSunday represents Easter, which falls on a Sunday.
 	EasterSunday = &cal.Holiday{
-		Name:   "Easter Sunday",
-		Offset: 0,
-		Func:   cal.CalcEasterOffset,
+		ID:           "au/easter-sunday",
+		Source:       "State and territory public holiday acts",
+		Subdivisions: []string{"AU-ACT", "AU-NSW", "AU-QLD", "AU-VIC"},
+		Name:         "Easter Sunday",
+		Names:        map[string]string{"en": "Easter Sunday"},
+		Offset:       0,
+		Func:         cal.CalcEasterOffset,
 	}
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "au/easter-monday",
+		Source: "Fair Work Act 2009 (Cth) s 115",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDayWa represents Labour Day in WA on the first Monday of March
 	LabourDayWa = &cal.Holiday{
-		Name:    "Labour Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.March,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/labour-day-wa",
+		Source:       "Public and Bank Holidays Act 1972 (WA)",
+		Subdivisions: []string{"AU-WA"},
+		Name:         "Labour Day",
+		Names:        map[string]string{"en": "Labour Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// LabourDayVic represents Labour Day in VIC on the second Monday of March
 	LabourDayVic = &cal.Holiday{
-		Name:    "Labour Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.March,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/labour-day-vic",
+		Source:       "Public Holidays Act 1993 (Vic)",
+		Subdivisions: []string{"AU-VIC"},
+		Name:         "Labour Day",
+		Names:        map[string]string{"en": "Labour Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Weekday:      time.Monday,
+		Offset:       2,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// LabourDayTas represents Eight Hours Day in TAS on the second Monday of March
 	LabourDayTas = &cal.Holiday{
-		Name:    "Eight Hours Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.March,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/labour-day-tas",
+		Source:       "Statutory Holidays Act 2000 (Tas)",
+		Subdivisions: []string{"AU-TAS"},
+		Name:         "Eight Hours Day",
+		Names:        map[string]string{"en": "Eight Hours Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Weekday:      time.Monday,
+		Offset:       2,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// CanberraDay represents Canberra Day in ACT on the second Monday of March
 	CanberraDay = &cal.Holiday{
-		Name:    "Canberra Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.March,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/canberra-day",
+		Source:       "Holidays Act 1958 (ACT)",
+		Subdivisions: []string{"AU-ACT"},
+		Name:         "Canberra Day",
+		Names:        map[string]string{"en": "Canberra Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Weekday:      time.Monday,
+		Offset:       2,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// MarchPublicHoliday represents March Public Holiday in SA on the second Monday of March
 	MarchPublicHoliday = &cal.Holiday{
-		Name:    "March Public Holiday",
-		Type:    cal.ObservancePublic,
-		Month:   time.March,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/march-public-holiday",
+		Source:       "Public Holidays Act 2023 (SA)",
+		Subdivisions: []string{"AU-SA"},
+		Name:         "March Public Holiday",
+		Names:        map[string]string{"en": "March Public Holiday"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Weekday:      time.Monday,
+		Offset:       2,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// AnzacDay represents ANZAC Day on 25-Apr
 	AnzacDay = &cal.Holiday{
-		Name:  "ANZAC Day",
-		Type:  cal.ObservancePublic,
-		Month: time.April,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:           "au/anzac-day",
+		Source:       "Fair Work Act 2009 (Cth) s 115",
+		Subdivisions: []string{"AU-NSW", "AU-TAS", "AU-VIC"},
+		Name:         "ANZAC Day",
+		Names:        map[string]string{"en": "ANZAC Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.April,
+		Day:          25,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// AnzacDayActWa represents ANZAC Day for ACT and WA who observe a public holiday if it falls on a weekend
-	AnzacDayActWa = AnzacDay.Clone(&cal.Holiday{Observed: weekendAlt})
+	AnzacDayActWa = AnzacDay.Clone(&cal.Holiday{
+		ID:           "au/anzac-day-act-wa",
+		Source:       "Fair Work Act 2009 (Cth) s 115",
+		Subdivisions: []string{"AU-ACT", "AU-WA"},
+		Observed:     weekendAlt,
+	})
 
 	// AnzacDayNtQldSa represents ANZAC Day for NT, QLD and SA who observe a public holiday on Monday if ANZAC day falls on a Sunday
-	AnzacDayNtQldSa = AnzacDay.Clone(&cal.Holiday{Observed: []cal.AltDay{{Day: time.Sunday, Offset: 1}}})
+	AnzacDayNtQldSa = AnzacDay.Clone(&cal.Holiday{
+		ID:           "au/anzac-day-nt-qld-sa",
+		Source:       "Fair Work Act 2009 (Cth) s 115",
+		Subdivisions: []string{"AU-NT", "AU-QLD", "AU-SA"},
+		Observed:     []cal.AltDay{{Day: time.Sunday, Offset: 1}},
+	})
+
+	// AnzacDayNational represents ANZAC Day on 25-Apr without the substitute
+	// days that differ between the states
+	AnzacDayNational = &cal.Holiday{
+		ID:     "au/anzac-day-national",
+		Source: "Fair Work Act 2009 (Cth) s 115",
+		Name:   "ANZAC Day",
+		Names:  map[string]string{"en": "ANZAC Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.April,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
+	}
 
 	// LabourDayNtQld represents May Day in NT and QLD on the first Monday of May
 	LabourDayNtQld = &cal.Holiday{
-		Name:    "Labour Day / May Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.May,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/labour-day-nt-qld",
+		Source:       "Public Holidays Act 1981 (NT); Holidays Act 1983 (Qld)",
+		Subdivisions: []string{"AU-NT", "AU-QLD"},
+		Name:         "Labour Day / May Day",
+		Names:        map[string]string{"en": "Labour Day / May Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.May,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// ReconciliationDay represents Reconciliation Day in ACT on the first Monday after or on 27-May
 	ReconciliationDay = &cal.Holiday{
-		Name:      "Reconciliation Day",
-		Type:      cal.ObservancePublic,
-		Month:     time.May,
-		Day:       27,
-		Weekday:   time.Monday,
-		Offset:    1,
-		Func:      cal.CalcWeekdayFrom,
-		StartYear: 2018,
+		ID:           "au/reconciliation-day",
+		Source:       "Holidays Act 1958 (ACT)",
+		Subdivisions: []string{"AU-ACT"},
+		Name:         "Reconciliation Day",
+		Names:        map[string]string{"en": "Reconciliation Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.May,
+		Day:          27,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayFrom,
+		StartYear:    2018,
 	}
 
 	// WesternAustraliaDay represents Western Australia Day on the first Monday in June
 	WesternAustraliaDay = &cal.Holiday{
-		Name:    "Western Australia Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.June,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/western-australia-day",
+		Source:       "Public and Bank Holidays Act 1972 (WA)",
+		Subdivisions: []string{"AU-WA"},
+		Name:         "Western Australia Day",
+		Names:        map[string]string{"en": "Western Australia Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.June,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// QueensBirthday represents Queen's Birthday on the second Monday in June
 	QueensBirthday = &cal.Holiday{
-		Name:    "Queen's Birthday",
-		Type:    cal.ObservancePublic,
-		Month:   time.June,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/queens-birthday",
+		Source:       "Fair Work Act 2009 (Cth) s 115",
+		Subdivisions: []string{"AU-ACT", "AU-NSW", "AU-NT", "AU-SA", "AU-TAS", "AU-VIC"},
+		Name:         "Queen's Birthday",
+		Names:        map[string]string{"en": "Queen's Birthday"},
+		Type:         cal.ObservancePublic,
+		Month:        time.June,
+		Weekday:      time.Monday,
+		Offset:       2,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// PicnicDay represents Picnic Day in NT on the first Monday in August
 	PicnicDay = &cal.Holiday{
-		Name:    "Picnic Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.August,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/picnic-day",
+		Source:       "Public Holidays Act 1981 (NT)",
+		Subdivisions: []string{"AU-NT"},
+		Name:         "Picnic Day",
+		Names:        map[string]string{"en": "Picnic Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.August,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// QueensBirthdayWa represents Queen's Birthday in WA on the last Monday in September
 	QueensBirthdayWa = &cal.Holiday{
-		Name:    "Queen's Birthday",
-		Type:    cal.ObservancePublic,
-		Month:   time.September,
-		Weekday: time.Monday,
-		Offset:  -1,
-		Func:    cal.CalcWeekdayOffset,
-		EndYear: 2021,
+		ID:           "au/queens-birthday-wa",
+		Source:       "Public and Bank Holidays Act 1972 (WA)",
+		Subdivisions: []string{"AU-WA"},
+		Name:         "Queen's Birthday",
+		Names:        map[string]string{"en": "Queen's Birthday"},
+		Type:         cal.ObservancePublic,
+		Month:        time.September,
+		Weekday:      time.Monday,
+		Offset:       -1,
+		Func:         cal.CalcWeekdayOffset,
+		EndYear:      2021,
 	}
 
 	// KingsBirthdayWa represents King's Birthday in WA previously known as the Queen’s Birthday;
 	// there is no firm rule to determine this date, though it is usually the last Monday of September
 	KingsBirthdayWa = &cal.Holiday{
-		Name:      "King's Birthday",
-		Type:      cal.ObservancePublic,
-		Func:      calcKingsBirthdayWa,
-		StartYear: 2022,
+		ID:           "au/kings-birthday-wa",
+		Source:       "Public and Bank Holidays Act 1972 (WA)",
+		Subdivisions: []string{"AU-WA"},
+		Name:         "King's Birthday",
+		Names:        map[string]string{"en": "King's Birthday"},
+		Type:         cal.ObservancePublic,
+		Func:         calcKingsBirthdayWa,
+		StartYear:    2022,
 	}
 
 	// FridayBeforeAflFinal represents the Friday before the AFL Grand Final;
 	// normally on the Friday before the last Saturday of September but subject to AFL schedules
 	FridayBeforeAflFinal = &cal.Holiday{
-		Name:      "Friday before the AFL Grand Final",
-		Type:      cal.ObservancePublic,
-		Func:      calcFridayBeforeAflFinal,
-		StartYear: 2015,
+		ID:           "au/friday-before-afl-final",
+		Source:       "Public Holidays Act 1993 (Vic)",
+		Subdivisions: []string{"AU-VIC"},
+		Name:         "Friday before the AFL Grand Final",
+		Names:        map[string]string{"en": "Friday before the AFL Grand Final"},
+		Type:         cal.ObservancePublic,
+		Func:         calcFridayBeforeAflFinal,
+		StartYear:    2015,
 	}
 
 	// QueensBirthdayQld represents Queen's Birthday in QLD on the first Monday in October
 	QueensBirthdayQld = &cal.Holiday{
-		Name:    "Queen's Birthday",
-		Type:    cal.ObservancePublic,
-		Month:   time.October,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/queens-birthday-qld",
+		Source:       "Holidays Act 1983 (Qld)",
+		Subdivisions: []string{"AU-QLD"},
+		Name:         "Queen's Birthday",
+		Names:        map[string]string{"en": "Queen's Birthday"},
+		Type:         cal.ObservancePublic,
+		Month:        time.October,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// LabourDayActNswSa represents Labour Day in ACT, NSW, and SA on the first Monday in October
 	LabourDayActNswSa = &cal.Holiday{
-		Name:    "Labour Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.October,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/labour-day-act-nsw-sa",
+		Source:       "Holidays Act 1958 (ACT); Public Holidays Act 2010 (NSW); Public Holidays Act 2023 (SA)",
+		Subdivisions: []string{"AU-ACT", "AU-NSW", "AU-SA"},
+		Name:         "Labour Day",
+		Names:        map[string]string{"en": "Labour Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.October,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// MelbourneCup represents Melbourne Cup day on the first Tuesday in November
 	MelbourneCup = &cal.Holiday{
-		Name:    "Melbourne Cup",
-		Type:    cal.ObservancePublic,
-		Month:   time.November,
-		Weekday: time.Tuesday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "au/melbourne-cup",
+		Source:       "Public Holidays Act 1993 (Vic)",
+		Subdivisions: []string{"AU-VIC"},
+		Name:         "Melbourne Cup",
+		Names:        map[string]string{"en": "Melbourne Cup"},
+		Type:         cal.ObservancePublic,
+		Month:        time.November,
+		Weekday:      time.Tuesday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "au/christmas-day",
+		Source:   "Fair Work Act 2009 (Cth) s 115",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// BoxingDay represents Boxing Day on 26-Dec
-	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Boxing Day", Type: cal.ObservanceBank,
+	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:           "au/boxing-day",
+		Source:       "Fair Work Act 2009 (Cth) s 115",
+		Subdivisions: []string{"AU-ACT", "AU-NSW", "AU-NT", "AU-QLD", "AU-TAS", "AU-VIC", "AU-WA"},
+		Name:         "Boxing Day",
+		Names:        map[string]string{"en": "Boxing Day"},
+		Type:         cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
 			{Day: time.Monday, Offset: 1}}})
 
+	// BoxingDayNational represents Boxing Day on 26-Dec without the substitute
+	// days that differ between the states
+	BoxingDayNational = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "au/boxing-day-national",
+		Source: "Fair Work Act 2009 (Cth) s 115",
+		Name:   "Boxing Day",
+		Names:  map[string]string{"en": "Boxing Day"},
+		Type:   cal.ObservanceBank,
+	})
+
 	// ProclamationDay represents Proclamation Day on 26-Dec
-	ProclamationDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Proclamation Day", Type: cal.ObservanceBank,
+	ProclamationDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:           "au/proclamation-day",
+		Source:       "Public Holidays Act 2023 (SA)",
+		Subdivisions: []string{"AU-SA"},
+		Name:         "Proclamation Day",
+		Names:        map[string]string{"en": "Proclamation Day"},
+		Type:         cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
@@ -247,7 +397,10 @@ var (
 
 	// MourningDay2022 represents the National Day of Mourning for Her Majesty the Queen.
 	MourningDay2022 = &cal.Holiday{
+		ID:        "au/mourning-day-2022",
+		Source:    "Prime Minister’s announcement of 11 September 2022",
 		Name:      "National Day of Mourning for Her Majesty the Queen",
+		Names:     map[string]string{"en": "National Day of Mourning for Her Majesty the Queen"},
 		Type:      cal.ObservancePublic,
 		Month:     time.September,
 		Day:       22,
@@ -256,6 +409,19 @@ var (
 		Func:      cal.CalcDayOfMonth,
 	}
 
+	// Holidays provides a list of the national holidays observed in every
+	// state and territory
+	Holidays = []*cal.Holiday{
+		NewYear,
+		AustraliaDay,
+		GoodFriday,
+		EasterMonday,
+		AnzacDayNational,
+		MourningDay2022,
+		ChristmasDay,
+		BoxingDayNational,
+	}
+
 	// HolidaysACT provides a list of standard holidays in the Australian Capital Territory region.
 	HolidaysACT = []*cal.Holiday{
 		NewYear,
@@ -385,27 +551,48 @@ var (
 		ChristmasDay,
 		BoxingDay,
 	}
+	// Subdivisions provides the holiday lists of each region by ISO 3166-2 code
+	Subdivisions = []*cal.Subdivision{
+		{Code: "AU-ACT", Name: "Australian Capital Territory", Holidays: HolidaysACT},
+		{Code: "AU-NSW", Name: "New South Wales", Holidays: HolidaysNSW},
+		{Code: "AU-NT", Name: "Northern Territory", Holidays: HolidaysNT},
+		{Code: "AU-QLD", Name: "Queensland", Holidays: HolidaysQLD},
+		{Code: "AU-SA", Name: "South Australia", Holidays: HolidaysSA},
+		{Code: "AU-TAS", Name: "Tasmania", Holidays: HolidaysTAS},
+		{Code: "AU-VIC", Name: "Victoria", Holidays: HolidaysVIC},
+		{Code: "AU-WA", Name: "Western Australia", Holidays: HolidaysWA},
+	}
 )
 
-func calcFridayBeforeAflFinal(_ *cal.Holiday, year int) time.Time {
+func calcFridayBeforeAflFinal(_ *cal.Holiday, year int, loc *time.Location) time.Time {
 	switch year {
 	case 2015:
-		return time.Date(year, time.October, 2, 0, 0, 0, 0, cal.DefaultLoc)
+		return time.Date(year, time.October, 2, 0, 0, 0, 0, loc)
 	case 2016:
-		return time.Date(year, time.September, 30, 0, 0, 0, 0, cal.DefaultLoc)
+		return time.Date(year, time.September, 30, 0, 0, 0, 0, loc)
 	case 2020:
-		return time.Date(year, time.October, 23, 0, 0, 0, 0, cal.DefaultLoc)
+		return time.Date(year, time.October, 23, 0, 0, 0, 0, loc)
 	default:
-		aflFinalDay := cal.DayStart(cal.WeekdayN(year, time.September, time.Saturday, -1))
+		aflFinalDay := cal.DayStart(cal.WeekdayNIn(year, time.September, time.Saturday, -1, loc))
 		return aflFinalDay.AddDate(0, 0, -1)
 	}
 }
 
-func calcKingsBirthdayWa(_ *cal.Holiday, year int) time.Time {
+func calcKingsBirthdayWa(_ *cal.Holiday, year int, loc *time.Location) time.Time {
 	switch year {
 	case 2024:
-		return time.Date(year, time.September, 23, 0, 0, 0, 0, cal.DefaultLoc)
+		return time.Date(year, time.September, 23, 0, 0, 0, 0, loc)
 	default:
-		return cal.DayStart(cal.WeekdayN(year, time.September, time.Monday, -1))
+		return cal.DayStart(cal.WeekdayNIn(year, time.September, time.Monday, -1, loc))
 	}
 }
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:         "AU",
+		Alpha3:       "AUS",
+		Name:         "Australia",
+		Holidays:     Holidays,
+		Subdivisions: Subdivisions,
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/caltest"
 )
 
 func d(y, m, d int) time.Time {
@@ -146,6 +147,10 @@ func TestHolidays(t *testing.T) {
 		{AnzacDayNtQldSa, 2021, d(2021, 4, 25), d(2021, 4, 26)},
 		{AnzacDayNtQldSa, 2022, d(2022, 4, 25), d(2022, 4, 25)},
 
+		{AnzacDayNational, 2020, d(2020, 4, 25), d(2020, 4, 25)},
+		{AnzacDayNational, 2021, d(2021, 4, 25), d(2021, 4, 25)},
+		{AnzacDayNational, 2022, d(2022, 4, 25), d(2022, 4, 25)},
+
 		{LabourDayNtQld, 2015, d(2015, 5, 4), d(2015, 5, 4)},
 		{LabourDayNtQld, 2016, d(2016, 5, 2), d(2016, 5, 2)},
 		{LabourDayNtQld, 2017, d(2017, 5, 1), d(2017, 5, 1)},
@@ -265,6 +270,10 @@ func TestHolidays(t *testing.T) {
 		{ProclamationDay, 2021, d(2021, 12, 26), d(2021, 12, 28)},
 		{ProclamationDay, 2022, d(2022, 12, 26), d(2022, 12, 27)},
 
+		{BoxingDayNational, 2020, d(2020, 12, 26), d(2020, 12, 26)},
+		{BoxingDayNational, 2021, d(2021, 12, 26), d(2021, 12, 26)},
+		{BoxingDayNational, 2022, d(2022, 12, 26), d(2022, 12, 26)},
+
 		{MourningDay2022, 2022, d(2022, 9, 22), d(2022, 9, 22)},
 	}
 
@@ -278,3 +287,33 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestNational(t *testing.T) {
+	c := cal.NewBusinessCalendarFor("AU")
+	if c == nil {
+		t.Fatal("no calendar for AU")
+	}
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{d(2021, 1, 1), false},   // New Year's Day
+		{d(2021, 1, 26), false},  // Australia Day
+		{d(2021, 4, 2), false},   // Good Friday
+		{d(2021, 4, 5), false},   // Easter Monday
+		{d(2022, 4, 25), false},  // ANZAC Day
+		{d(2021, 12, 27), false}, // Christmas Day observed
+		{d(2023, 12, 26), false}, // Boxing Day
+		{d(2021, 3, 8), true},    // Labour Day in some states only
+		{d(2021, 6, 14), true},   // Queen's Birthday in most states only
+	}
+	for _, test := range tests {
+		if got := c.IsWorkday(test.t); got != test.want {
+			t.Errorf("%s: got: %t; want: %t", test.t, got, test.want)
+		}
+	}
+}
+
+func TestFixture(t *testing.T) {
+	caltest.CheckFixture(t, "AU", "testdata/holidays.csv")
+}
@@ -12,40 +12,97 @@ import (
 
 var (
 	// Nieuwjaar represents New Year's Day on 1-Jan
-	Nieuwjaar = aa.NewYear.Clone(&cal.Holiday{Name: "Nieuwjaarsdag", Type: cal.ObservancePublic})
+	Nieuwjaar = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "be/nieuwjaar",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Nieuwjaarsdag",
+		Names:  map[string]string{"nl": "Nieuwjaarsdag", "fr": "Jour de l'an", "de": "Neujahr", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Paasmaandag represents Easter Monday on the day after Easter
-	Paasmaandag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Paasmaandag", Type: cal.ObservancePublic})
+	Paasmaandag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "be/paasmaandag",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Paasmaandag",
+		Names:  map[string]string{"nl": "Paasmaandag", "fr": "Lundi de Pâques", "de": "Ostermontag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DagVanDeArbeid represents Labor Day on the first Monday in May
-	DagVanDeArbeid = aa.WorkersDay.Clone(&cal.Holiday{Name: "Dag van de Arbeid", Type: cal.ObservancePublic})
+	DagVanDeArbeid = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "be/dag-van-de-arbeid",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Dag van de Arbeid",
+		Names:  map[string]string{"nl": "Dag van de Arbeid", "fr": "Fête du Travail", "de": "Tag der Arbeit", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// OnzeLieveHeerHemelvaart represents Ascension Day on the 39th day after Easter
-	OnzeLieveHeerHemelvaart = aa.AscensionDay.Clone(&cal.Holiday{Name: "Onze Lieve Heer Hemelvaart", Type: cal.ObservancePublic})
+	OnzeLieveHeerHemelvaart = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "be/onze-lieve-heer-hemelvaart",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Onze Lieve Heer Hemelvaart",
+		Names:  map[string]string{"nl": "Onze Lieve Heer Hemelvaart", "fr": "Ascension", "de": "Christi Himmelfahrt", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pinkstermaandag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	Pinkstermaandag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Pinkstermaandag", Type: cal.ObservancePublic})
+	Pinkstermaandag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "be/pinkstermaandag",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Pinkstermaandag",
+		Names:  map[string]string{"nl": "Pinkstermaandag", "fr": "Lundi de Pentecôte", "de": "Pfingstmontag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NationaleFeestdag represents Belgian National Day on 21-Jul
 	NationaleFeestdag = &cal.Holiday{
-		Name:  "Nationale Feestdag",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   21,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "be/nationale-feestdag",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Nationale Feestdag",
+		Names:  map[string]string{"nl": "Nationale Feestdag", "fr": "Fête nationale", "de": "Nationalfeiertag", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    21,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// OnzeLieveVrouwHemelvaart represents Assumption of Mary on 15-Aug
-	OnzeLieveVrouwHemelvaart = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Onze Lieve Vrouw Hemelvaart", Type: cal.ObservancePublic})
+	OnzeLieveVrouwHemelvaart = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "be/onze-lieve-vrouw-hemelvaart",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Onze Lieve Vrouw Hemelvaart",
+		Names:  map[string]string{"nl": "Onze Lieve Vrouw Hemelvaart", "fr": "Assomption", "de": "Mariä Himmelfahrt", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Allerheiligen represents All Saints' Day on 1-Nov
-	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Allerheiligen", Type: cal.ObservancePublic})
+	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "be/allerheiligen",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Allerheiligen",
+		Names:  map[string]string{"nl": "Allerheiligen", "fr": "Toussaint", "de": "Allerheiligen", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Wapenstilstand represents Armistice Day on 11-Nov
-	Wapenstilstand = aa.ArmisticeDay.Clone(&cal.Holiday{Name: "Wapenstilstand", Type: cal.ObservancePublic})
+	Wapenstilstand = aa.ArmisticeDay.Clone(&cal.Holiday{
+		ID:     "be/wapenstilstand",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Wapenstilstand",
+		Names:  map[string]string{"nl": "Wapenstilstand", "fr": "Armistice", "de": "Waffenstillstand", "en": "Armistice Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Kerstmis represents Christmas Day on 25-Dec
-	Kerstmis = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Kerstmis", Type: cal.ObservancePublic})
+	Kerstmis = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "be/kerstmis",
+		Source: "Loi du 4 janvier 1974 relative aux jours fériés",
+		Name:   "Kerstmis",
+		Names:  map[string]string{"nl": "Kerstmis", "fr": "Noël", "de": "Weihnachten", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -61,3 +118,12 @@ var (
 		Kerstmis,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "BE",
+		Alpha3:   "BEL",
+		Name:     "Belgium",
+		Holidays: Holidays,
+	})
+}
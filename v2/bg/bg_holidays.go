@@ -16,11 +16,21 @@ var (
 		{Day: time.Sunday, Offset: 1},
 	}
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Нова година", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "bg/new-year",
+		Source:   "Кодекс на труда, чл. 154",
+		Name:     "Нова година",
+		Names:    map[string]string{"bg": "Нова година", "en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// LiberationDay represents Liberation Day on 3-Mar
 	LiberationDay = &cal.Holiday{
+		ID:       "bg/liberation-day",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Ден на Освобождението на България от османско иго - национален празник",
+		Names:    map[string]string{"bg": "Ден на Освобождението на България от османско иго - национален празник", "en": "Liberation Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      3,
@@ -30,7 +40,10 @@ var (
 
 	// GoodFriday represents Good Friday - two days before Easter
 	OrthodoxGoodFriday = &cal.Holiday{
+		ID:     "bg/orthodox-good-friday",
+		Source: "Кодекс на труда, чл. 154",
 		Name:   "Велики петък",
+		Names:  map[string]string{"bg": "Велики петък", "en": "Good Friday"},
 		Type:   cal.ObservancePublic,
 		Offset: -2,
 		Julian: true,
@@ -39,7 +52,10 @@ var (
 
 	// EasterMonday represents Easter Monday on the day after Easter
 	OrthodoxEasterMonday = &cal.Holiday{
+		ID:     "bg/orthodox-easter-monday",
+		Source: "Кодекс на труда, чл. 154",
 		Name:   "Великден",
+		Names:  map[string]string{"bg": "Великден", "en": "Easter Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 1,
 		Julian: true,
@@ -48,22 +64,25 @@ var (
 
 	// LabourDay represents Labour Day on 1-May
 	LabourDay = &cal.Holiday{
-		Name:  "Ден на труда и на международната работническа солидарност",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   1,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:     "bg/labour-day",
+		Source: "Кодекс на труда, чл. 154",
+		Name:   "Ден на труда и на международната работническа солидарност",
+		Names:  map[string]string{"bg": "Ден на труда и на международната работническа солидарност", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    1,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			// May 1st is quite close to OrthodoxEaster so we need to make an extra check
 			// If LabourDay falls on a Saturday or Sunday and Good Friday is on the Friday before that,
 			// then LabourDay is observed on the Tuesday after Easter.
-			easter := cal.CalcEasterOffset(OrthodoxGoodFriday, year)
-			labourDay := cal.CalcDayOfMonth(h, year)
+			easter := cal.CalcEasterOffset(OrthodoxGoodFriday, year, loc)
+			labourDay := cal.CalcDayOfMonth(h, year, loc)
 			daysDiff := labourDay.Sub(easter).Hours() / 24
 			if daysDiff <= 2 {
 				holiday := *h
 				holiday.Offset = 2
 				holiday.Julian = true
-				return cal.CalcEasterOffset(&holiday, year)
+				return cal.CalcEasterOffset(&holiday, year, loc)
 			}
 			return labourDay
 		},
@@ -72,7 +91,10 @@ var (
 
 	// StGeorgesDay represents St. George's Day on 6-May
 	StGeorgesDay = &cal.Holiday{
+		ID:       "bg/st-georges-day",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Гергьовден, Ден на храбростта и Българската армия",
+		Names:    map[string]string{"bg": "Гергьовден, Ден на храбростта и Българската армия", "en": "St. George's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      6,
@@ -82,7 +104,10 @@ var (
 
 	// StCyrilAndMethodiusDay represents St. Cyril and St. Methodius Day on 24-May
 	StCyrilAndMethodiusDay = &cal.Holiday{
+		ID:       "bg/st-cyril-and-methodius-day",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Ден на светите братя Кирил и Методий, на българската азбука, просвета и култура и на славянската книжовност",
+		Names:    map[string]string{"bg": "Ден на светите братя Кирил и Методий, на българската азбука, просвета и култура и на славянската книжовност", "en": "Day of Bulgarian Education and Culture and Slavonic Literature"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      24,
@@ -92,7 +117,10 @@ var (
 
 	// UnificationDay represents Unification Day on 6-Sep
 	UnificationDay = &cal.Holiday{
+		ID:       "bg/unification-day",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Ден на Съединението",
+		Names:    map[string]string{"bg": "Ден на Съединението", "en": "Unification Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.September,
 		Day:      6,
@@ -102,7 +130,10 @@ var (
 
 	// IndependenceDay represents Independence Day on 22-Sep
 	IndependenceDay = &cal.Holiday{
+		ID:       "bg/independence-day",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Ден на Независимостта на България",
+		Names:    map[string]string{"bg": "Ден на Независимостта на България", "en": "Independence Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.September,
 		Day:      22,
@@ -112,7 +143,10 @@ var (
 
 	// ChristmasEve represents Christmas Eve 24-Dec
 	ChristmasEve = &cal.Holiday{
+		ID:       "bg/christmas-eve",
+		Source:   "Кодекс на труда, чл. 154",
 		Name:     "Бъдни вечер",
+		Names:    map[string]string{"bg": "Бъдни вечер", "en": "Christmas Eve"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      24,
@@ -121,17 +155,29 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Коледа", Type: cal.ObservancePublic, Observed: []cal.AltDay{
-		{Day: time.Saturday, Offset: 2},
-		{Day: time.Sunday, Offset: 2},
-	}})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "bg/christmas-day",
+		Source: "Кодекс на труда, чл. 154",
+		Name:   "Коледа",
+		Names:  map[string]string{"bg": "Коледа", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+		Observed: []cal.AltDay{
+			{Day: time.Saturday, Offset: 2},
+			{Day: time.Sunday, Offset: 2},
+		}})
 
 	// ChristmasDay2 represents Christmas Day 2 on 26-Dec
-	ChristmasDay2 = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Коледа 2", Type: cal.ObservancePublic, Observed: []cal.AltDay{
-		{Day: time.Saturday, Offset: 2},
-		{Day: time.Sunday, Offset: 2},
-		{Day: time.Monday, Offset: 2},
-		{Day: time.Tuesday, Offset: 1}}})
+	ChristmasDay2 = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "bg/christmas-day-2",
+		Source: "Кодекс на труда, чл. 154",
+		Name:   "Коледа 2",
+		Names:  map[string]string{"bg": "Коледа 2", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
+		Observed: []cal.AltDay{
+			{Day: time.Saturday, Offset: 2},
+			{Day: time.Sunday, Offset: 2},
+			{Day: time.Monday, Offset: 2},
+			{Day: time.Tuesday, Offset: 1}}})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -149,3 +195,12 @@ var (
 		ChristmasDay2,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "BG",
+		Alpha3:   "BGR",
+		Name:     "Bulgaria",
+		Holidays: Holidays,
+	})
+}
@@ -12,74 +12,125 @@ import (
 
 var (
 	// AnoNovo represents New Year's Day on 1-Jan
-	AnoNovo = aa.NewYear.Clone(&cal.Holiday{Name: "Ano Novo", Type: cal.ObservancePublic})
+	AnoNovo = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "br/ano-novo",
+		Source: "Lei nº 662/1949",
+		Name:   "Ano Novo",
+		Names:  map[string]string{"pt": "Ano Novo", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Tiradentes represents Tiradentes' Day on 21-Apr
 	Tiradentes = &cal.Holiday{
-		Name:  "Tiradentes",
-		Month: time.April,
-		Day:   21,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/tiradentes",
+		Source: "Lei nº 662/1949",
+		Name:   "Tiradentes",
+		Names:  map[string]string{"pt": "Tiradentes", "en": "Tiradentes' Day"},
+		Month:  time.April,
+		Day:    21,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Trabalhador represents Labor Day on 1-May
-	Trabalhador = aa.WorkersDay.Clone(&cal.Holiday{Name: "Dia do Trabalhador", Type: cal.ObservancePublic})
+	Trabalhador = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "br/trabalhador",
+		Source: "Lei nº 662/1949",
+		Name:   "Dia do Trabalhador",
+		Names:  map[string]string{"pt": "Dia do Trabalhador", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Independencia represents Brazil Independence Day on 07-Sep
 	Independencia = &cal.Holiday{
-		Name:  "Independência do Brasil",
-		Month: time.September,
-		Day:   7,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/independencia",
+		Source: "Lei nº 662/1949",
+		Name:   "Independência do Brasil",
+		Names:  map[string]string{"pt": "Independência do Brasil", "en": "Independence Day"},
+		Month:  time.September,
+		Day:    7,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// NossaSenhoraAparecida represents Our Lady of Aparecida Day - Patroness of Brazil on 12-Oct
 	NossaSenhoraAparecida = &cal.Holiday{
-		Name:  "Nossa Senhora Aparecida",
-		Month: time.October,
-		Day:   12,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/nossa-senhora-aparecida",
+		Source: "Lei nº 6.802/1980",
+		Name:   "Nossa Senhora Aparecida",
+		Names:  map[string]string{"pt": "Nossa Senhora Aparecida", "en": "Our Lady of Aparecida"},
+		Month:  time.October,
+		Day:    12,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Finados represents Day of the Dead on 02-Nov
 	Finados = &cal.Holiday{
-		Name:  "Finados",
-		Month: time.November,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/finados",
+		Source: "Lei nº 662/1949",
+		Name:   "Finados",
+		Names:  map[string]string{"pt": "Finados", "en": "All Souls' Day"},
+		Month:  time.November,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Republica represents Proclamation of the Republic on 15-Nov
 	Republica = &cal.Holiday{
-		Name:  "Proclamação da República",
-		Month: time.November,
-		Day:   15,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/republica",
+		Source: "Lei nº 662/1949",
+		Name:   "Proclamação da República",
+		Names:  map[string]string{"pt": "Proclamação da República", "en": "Proclamation of the Republic"},
+		Month:  time.November,
+		Day:    15,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// CorpusChristi represents Corpus Christi on the 60th day after Easter
-	CorpusChristi = aa.CorpusChristi.Clone(&cal.Holiday{Name: "Corpus Christi", Type: cal.ObservancePublic})
+	CorpusChristi = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:     "br/corpus-christi",
+		Source: "Lei nº 9.093/1995",
+		Name:   "Corpus Christi",
+		Names:  map[string]string{"pt": "Corpus Christi", "en": "Corpus Christi"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SextaFeiraSanta represents Good Friday - two days before Easter
-	SextaFeiraSanta = aa.GoodFriday.Clone(&cal.Holiday{Name: "Sexta-feira Santa", Type: cal.ObservancePublic})
+	SextaFeiraSanta = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "br/sexta-feira-santa",
+		Source: "Lei nº 9.093/1995",
+		Name:   "Sexta-feira Santa",
+		Names:  map[string]string{"pt": "Sexta-feira Santa", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Carnaval represents Brazilian Carnival - 47 days before Easter
 	Carnaval = &cal.Holiday{
+		ID:     "br/carnaval",
+		Source: "Ponto facultativo federal",
 		Name:   "Carnaval",
+		Names:  map[string]string{"pt": "Carnaval", "en": "Carnival"},
 		Type:   cal.ObservancePublic,
 		Offset: -47,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// Natal represents Christmas Day on 25-Dec
-	Natal = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Natal", Type: cal.ObservancePublic})
+	Natal = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "br/natal",
+		Source: "Lei nº 662/1949",
+		Name:   "Natal",
+		Names:  map[string]string{"pt": "Natal", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ConscienciaNegra represents Black Awareness Day on 20-Nov
 	ConscienciaNegra = &cal.Holiday{
-		Name:  "Dia da Consciência Negra",
-		Month: time.November,
-		Day:   20,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "br/consciencia-negra",
+		Source: "Lei nº 14.759/2023",
+		Name:   "Dia da Consciência Negra",
+		Names:  map[string]string{"pt": "Dia da Consciência Negra", "en": "Black Consciousness Day"},
+		Month:  time.November,
+		Day:    20,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -98,3 +149,12 @@ var (
 		ConscienciaNegra,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "BR",
+		Alpha3:   "BRA",
+		Name:     "Brazil",
+		Holidays: Holidays,
+	})
+}
@@ -22,21 +22,41 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic,
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "ca/new-year",
+		Source: "Canada Labour Code, s. 166",
+		Name:   "New Year's Day",
+		Names:  map[string]string{"en": "New Year's Day", "fr": "Jour de l'An"},
+		Type:   cal.ObservancePublic,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 1},
 		}})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "ca/good-friday",
+		Source: "Canada Labour Code, s. 166",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday", "fr": "Vendredi saint"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "ca/easter-monday",
+		Source: "Treasury Board collective agreements",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday", "fr": "Lundi de Pâques"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// VictoriaDay represents Victoria Day on the Monday before 25-May
 	VictoriaDay = &cal.Holiday{
+		ID:      "ca/victoria-day",
+		Source:  "Canada Labour Code, s. 166",
 		Name:    "Victoria Day",
+		Names:   map[string]string{"en": "Victoria Day", "fr": "Fête de la Reine"},
 		Type:    cal.ObservancePublic,
 		Month:   time.May,
 		Weekday: time.Monday,
@@ -46,7 +66,10 @@ var (
 
 	// CanadaDay represents Canada Day on 1-July
 	CanadaDay = &cal.Holiday{
+		ID:       "ca/canada-day",
+		Source:   "Canada Labour Code, s. 166",
 		Name:     "Canada Day",
+		Names:    map[string]string{"en": "Canada Day", "fr": "Fête du Canada"},
 		Type:     cal.ObservancePublic,
 		Month:    time.July,
 		Day:      1,
@@ -56,7 +79,10 @@ var (
 
 	// CivicDay represents Civic/Provincial Day on the first Monday of August
 	CivicDay = &cal.Holiday{
+		ID:      "ca/civic-day",
+		Source:  "Treasury Board collective agreements",
 		Name:    "Civic/Provincial Day",
+		Names:   map[string]string{"en": "Civic/Provincial Day", "fr": "Congé civique"},
 		Type:    cal.ObservancePublic,
 		Month:   time.August,
 		Weekday: time.Monday,
@@ -66,7 +92,10 @@ var (
 
 	// LabourDay represents Labour Day on the first Monday of September
 	LabourDay = &cal.Holiday{
+		ID:      "ca/labour-day",
+		Source:  "Canada Labour Code, s. 166",
 		Name:    "Labour Day",
+		Names:   map[string]string{"en": "Labour Day", "fr": "Fête du Travail"},
 		Type:    cal.ObservancePublic,
 		Month:   time.September,
 		Weekday: time.Monday,
@@ -76,7 +105,10 @@ var (
 
 	// ThanksgivingDay represents ThanksgivingDay on the second Monday of October
 	ThanksgivingDay = &cal.Holiday{
+		ID:      "ca/thanksgiving-day",
+		Source:  "Canada Labour Code, s. 166",
 		Name:    "Thanksgiving Day",
+		Names:   map[string]string{"en": "Thanksgiving Day", "fr": "Action de grâce"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Weekday: time.Monday,
@@ -86,8 +118,11 @@ var (
 
 	// National Day for Truth and Reconciliation on 30-Sep
 	NationalDayForTruthAndReconciliation = &cal.Holiday{
+		ID:        "ca/national-day-for-truth-and-reconciliation",
+		Source:    "Canada Labour Code, s. 166",
 		StartYear: 2021,
 		Name:      "National Day for Truth and Reconciliation",
+		Names:     map[string]string{"en": "National Day for Truth and Reconciliation", "fr": "Journée nationale de la vérité et de la réconciliation"},
 		Month:     time.September,
 		Day:       30,
 		Func:      cal.CalcDayOfMonth,
@@ -95,15 +130,32 @@ var (
 	}
 
 	// RemembranceDay represents Remembrance Day on 11-Nov
-	RemembranceDay = aa.ArmisticeDay.Clone(&cal.Holiday{Name: "Remembrance Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	RemembranceDay = aa.ArmisticeDay.Clone(&cal.Holiday{
+		ID:       "ca/remembrance-day",
+		Source:   "Canada Labour Code, s. 166",
+		Name:     "Remembrance Day",
+		Names:    map[string]string{"en": "Remembrance Day", "fr": "Jour du Souvenir"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank,
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "ca/christmas-day",
+		Source:   "Canada Labour Code, s. 166",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day", "fr": "Noël"},
+		Type:     cal.ObservanceBank,
 		Observed: weekendAlt,
 	})
 
 	// BoxingDay represents Boxing Day on 26-Dec
-	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Boxing Day", Type: cal.ObservanceBank,
+	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "ca/boxing-day",
+		Source: "Canada Labour Code, s. 166",
+		Name:   "Boxing Day",
+		Names:  map[string]string{"en": "Boxing Day", "fr": "Lendemain de Noël"},
+		Type:   cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
@@ -125,3 +177,12 @@ var (
 		BoxingDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "CA",
+		Alpha3:   "CAN",
+		Name:     "Canada",
+		Holidays: Holidays,
+	})
+}
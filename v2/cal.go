@@ -8,7 +8,9 @@ import (
 )
 
 // DefaultLoc is the default time.Location to use in functions that do not
-// require a full time.Time value.
+// require a full time.Time value, such as Holiday.Calc and WeekdayN.
+//
+// Calendars don't depend on DefaultLoc; see Calendar.Location.
 var DefaultLoc = time.Local
 
 // CacheMaxSize is the maximum number of items that can be stored in the cache
@@ -17,13 +19,27 @@ var CacheMaxSize = 365 * 3
 // CacheEvictSize is the number of items to evict from cache when it is full
 var CacheEvictSize = 30
 
+// LocationMatch determines how locations are compared when checking whether
+// a calendar is applicable.
+type LocationMatch uint8
+
+// Allowed values for LocationMatch
+const (
+	MatchZoneName LocationMatch = iota // locations match if they have the same name, e.g. "Europe/Berlin"
+	MatchOffset                        // locations match if they have the same UTC offsets in January and July
+)
+
 // Calendar represents a basic yearly calendar with a list of holidays.
 type Calendar struct {
-	Name        string           // calendar short name
-	Description string           // calendar description
-	Locations   []*time.Location // locations where the calendar applies
-	Holidays    []*Holiday       // applicable holidays for this calendar
-	Cacheable   bool             // indicates that holiday calcs can be cached (don't change holiday defs while enabled)
+	Name          string           // calendar short name
+	Description   string           // calendar description
+	Location      *time.Location   // location of holiday dates (UTC if nil) and business hours (the time's own if nil)
+	Locations     []*time.Location // locations where the calendar applies
+	LocationMatch LocationMatch    // how locations are compared to the calendar's Locations
+	Holidays      []*Holiday       // applicable holidays for this calendar
+	Cacheable     bool             // indicates that holiday calcs can be cached (don't change holiday defs while enabled)
+
+	holidayFunc func(date time.Time) (actual, observed bool, h *Holiday) // additional holidays, e.g. from member calendars
 
 	isHolCache map[holCacheKey]*holCacheEntry // cached results for IsHoliday
 
@@ -49,8 +65,18 @@ var holFalseEntry *holCacheEntry = &holCacheEntry{act: false, obs: false, hol: n
 // IsApplicable reports whether the calendar is applicable for the given
 // location.
 //
+// Locations are compared according to the calendar's LocationMatch setting so
+// that locations loaded separately with time.LoadLocation will match. UTC
+// offsets are compared using the time zone rules for the current year.
+//
 // If no locations have been specified for the calendar, true is returned.
 func (c *Calendar) IsApplicable(loc *time.Location) bool {
+	return c.isApplicable(loc, time.Now().Year())
+}
+
+// isApplicable reports whether the calendar is applicable for the given
+// location using the time zone rules in effect for the given year.
+func (c *Calendar) isApplicable(loc *time.Location, year int) bool {
 	if c.Locations == nil {
 		return true
 	}
@@ -59,11 +85,34 @@ func (c *Calendar) IsApplicable(loc *time.Location) bool {
 		if l == loc {
 			return true
 		}
+		switch c.LocationMatch {
+		case MatchZoneName:
+			if l != nil && loc != nil && l.String() == loc.String() {
+				return true
+			}
+		case MatchOffset:
+			if l != nil && loc != nil && sameOffsets(l, loc, year) {
+				return true
+			}
+		}
 	}
 
 	return false
 }
 
+// sameOffsets reports whether two locations have the same UTC offsets in
+// January and July of the given year.
+func sameOffsets(l1, l2 *time.Location, year int) bool {
+	for _, m := range []time.Month{time.January, time.July} {
+		_, off1 := time.Date(year, m, 1, 12, 0, 0, 0, l1).Zone()
+		_, off2 := time.Date(year, m, 1, 12, 0, 0, 0, l2).Zone()
+		if off1 != off2 {
+			return false
+		}
+	}
+	return true
+}
+
 // AddHoliday adds a holiday to the calendar's list.
 func (c *Calendar) AddHoliday(h ...*Holiday) {
 	if c.Holidays == nil {
@@ -74,12 +123,24 @@ func (c *Calendar) AddHoliday(h ...*Holiday) {
 }
 
 // IsHoliday reports whether a given date is a holiday or an observation day.
+//
+// Only the year, month and day of date in its own location are considered; the
+// time of day, DefaultLoc and the calendar's Location don't affect the result.
 func (c *Calendar) IsHoliday(date time.Time) (actual, observed bool, h *Holiday) {
-	if c.Holidays == nil || !c.IsApplicable(date.Location()) {
-		return false, false, nil
+	actual, observed, h = c.isHoliday(date)
+	if h == nil && c.holidayFunc != nil {
+		return c.holidayFunc(date)
 	}
+	return actual, observed, h
+}
 
+// isHoliday reports whether a given date is one of the calendar's holidays or
+// observation days.
+func (c *Calendar) isHoliday(date time.Time) (actual, observed bool, h *Holiday) {
 	year, month, day := date.Date()
+	if c.Holidays == nil || !c.isApplicable(date.Location(), year) {
+		return false, false, nil
+	}
 
 	if c.Cacheable {
 		c.isHolCacheInitOnce.Do(func() {
@@ -95,71 +156,15 @@ func (c *Calendar) IsHoliday(date time.Time) (actual, observed bool, h *Holiday)
 	}
 
 	for _, hol := range c.Holidays {
-		act, obs := hol.Calc(year)
-
-		actMatch := !act.IsZero()
-		if actMatch {
-			_, actMonth, actDay := act.Date()
-			actMatch = actMonth == month && actDay == day
-		}
-		obsMatch := !obs.IsZero()
-		if obsMatch {
-			obsYear, obsMonth, obsDay := obs.Date()
-			obsMatch = obsYear == year && obsMonth == month && obsDay == day
-		}
-		if actMatch || obsMatch {
+		if act, obs := c.occursOn(hol, year, month, day); act || obs {
 			if c.Cacheable {
 				c.isHolCacheMutex.Lock()
 				c.evict()
 				c.isHolCache[holCacheKey{year: year, month: month, day: day}] =
-					&holCacheEntry{act: actMatch, obs: obsMatch, hol: hol}
+					&holCacheEntry{act: act, obs: obs, hol: hol}
 				c.isHolCacheMutex.Unlock()
 			}
-			return actMatch, obsMatch, hol
-		}
-
-		// handle holidays that wrap around to the next or previous year
-		// e.g., New Year's Day on Saturday 1 Jan observed on Friday 31 Dec
-		if hol.Observed != nil {
-			actMonth := time.Month(0)
-			if !act.IsZero() {
-				actMonth = act.Month()
-			}
-			if actMonth == time.January {
-				_, obs = hol.Calc(year + 1)
-				obsMatch := !obs.IsZero()
-				if obsMatch {
-					obsYear, obsMonth, obsDay := obs.Date()
-					obsMatch = obsYear == year && obsMonth == month && obsDay == day
-				}
-				if obsMatch {
-					if c.Cacheable {
-						c.isHolCacheMutex.Lock()
-						c.evict()
-						c.isHolCache[holCacheKey{year: year, month: month, day: day}] =
-							&holCacheEntry{act: false, obs: obsMatch, hol: hol}
-						c.isHolCacheMutex.Unlock()
-					}
-					return false, obsMatch, hol
-				}
-			} else if actMonth == time.December {
-				_, obs = hol.Calc(year - 1)
-				obsMatch := !obs.IsZero()
-				if obsMatch {
-					obsYear, obsMonth, obsDay := obs.Date()
-					obsMatch = obsYear == year && obsMonth == month && obsDay == day
-				}
-				if obsMatch {
-					if c.Cacheable {
-						c.isHolCacheMutex.Lock()
-						c.evict()
-						c.isHolCache[holCacheKey{year: year, month: month, day: day}] =
-							&holCacheEntry{act: false, obs: obsMatch, hol: hol}
-						c.isHolCacheMutex.Unlock()
-					}
-					return false, obsMatch, hol
-				}
-			}
+			return act, obs, hol
 		}
 	}
 
@@ -172,6 +177,75 @@ func (c *Calendar) IsHoliday(date time.Time) (actual, observed bool, h *Holiday)
 	return false, false, nil
 }
 
+// occursOn reports whether the holiday occurs or is observed on the given day.
+func (c *Calendar) occursOn(hol *Holiday, year int, month time.Month, day int) (actual, observed bool) {
+	act, obs := hol.CalcIn(year, c.loc())
+
+	actMatch := !act.IsZero()
+	if actMatch {
+		_, actMonth, actDay := act.Date()
+		actMatch = actMonth == month && actDay == day
+	}
+	obsMatch := !obs.IsZero()
+	if obsMatch {
+		obsYear, obsMonth, obsDay := obs.Date()
+		obsMatch = obsYear == year && obsMonth == month && obsDay == day
+	}
+	if actMatch || obsMatch || (hol.Observed == nil && hol.ObservedFunc == nil) {
+		return actMatch, obsMatch
+	}
+
+	// handle holidays that wrap around to the next or previous year
+	// e.g., New Year's Day on Saturday 1 Jan observed on Friday 31 Dec
+	actMonth := time.Month(0)
+	if !act.IsZero() {
+		actMonth = act.Month()
+	}
+	if actMonth == time.January {
+		_, obs = hol.CalcIn(year+1, c.loc())
+	} else if actMonth == time.December {
+		_, obs = hol.CalcIn(year-1, c.loc())
+	} else {
+		return false, false
+	}
+	obsYear, obsMonth, obsDay := obs.Date()
+	return false, !obs.IsZero() && obsYear == year && obsMonth == month && obsDay == day
+}
+
+// HolidayOccurrence represents a day on which a holiday occurs or is
+// observed.
+type HolidayOccurrence struct {
+	Date     time.Time // the start of the day
+	Holiday  *Holiday  // the holiday
+	Actual   bool      // the holiday occurs on the day
+	Observed bool      // the holiday is observed on the day
+}
+
+// Occurrences reports the days between the start and end dates (inclusive)
+// that are holidays or observation days, in ascending order. Each day is
+// reported as IsHoliday would report it.
+func (c *Calendar) Occurrences(start, end time.Time) []HolidayOccurrence {
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var r []HolidayOccurrence
+	for day := DayStart(start); !day.After(end); day = DayStart(addDays(day, 1)) {
+		if act, obs, h := c.IsHoliday(day); h != nil {
+			r = append(r, HolidayOccurrence{Date: day, Holiday: h, Actual: act, Observed: obs})
+		}
+	}
+	return r
+}
+
+// loc reports the location used for holiday calculations.
+func (c *Calendar) loc() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
 func (c *Calendar) evict() {
 	if len(c.isHolCache) >= CacheMaxSize {
 		n := 0
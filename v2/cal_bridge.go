@@ -0,0 +1,103 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"sort"
+	"time"
+)
+
+// Bridge represents leave days that join a holiday to other days off, such as
+// taking the Friday off after a Thursday holiday (known as a Brückentag in
+// German or a puente in Spanish).
+type Bridge struct {
+	Leave   []time.Time // the workdays to take as leave
+	Start   time.Time   // the first day of the resulting time off
+	End     time.Time   // the last day of the resulting time off
+	DaysOff int         // the number of consecutive days off, including leave
+}
+
+// Ratio reports the number of days off gained for each leave day spent.
+func (b Bridge) Ratio() float64 {
+	if len(b.Leave) == 0 {
+		return 0
+	}
+	return float64(b.DaysOff) / float64(len(b.Leave))
+}
+
+// BridgeDays reports the bridges that can be taken between the start and end
+// dates (inclusive) using at most maxLeave consecutive workdays of leave.
+//
+// A bridge is a run of workdays with non-workdays on both sides where at
+// least one side includes a holiday that is a day off (see Policy). Workdays
+// are determined by IsWorkday, so weekends, holidays and custom workday
+// functions are all taken into account.
+//
+// The results are ranked by the number of days off gained for each leave day
+// (best first), then by the fewest leave days and then by date.
+func (c *BusinessCalendar) BridgeDays(start, end time.Time, maxLeave int) []Bridge {
+	var r []Bridge
+	if maxLeave <= 0 {
+		return r
+	}
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	to := DayStart(end)
+	for i := DayStart(start); !i.After(to); i = i.AddDate(0, 0, 1) {
+		if !c.IsWorkday(i) || c.IsWorkday(i.AddDate(0, 0, -1)) {
+			continue
+		}
+
+		// i is the first workday after a non-workday; find the end of the run
+		var leave []time.Time
+		j := i
+		for ; len(leave) <= maxLeave && !j.After(to) && c.IsWorkday(j); j = j.AddDate(0, 0, 1) {
+			leave = append(leave, j)
+		}
+		if len(leave) > maxLeave || c.IsWorkday(j) {
+			continue
+		}
+
+		first, before, beforeHol := c.offBlock(i.AddDate(0, 0, -1), -1)
+		last, after, afterHol := c.offBlock(j, 1)
+		if !beforeHol && !afterHol {
+			continue
+		}
+
+		r = append(r, Bridge{
+			Leave:   leave,
+			Start:   first,
+			End:     last,
+			DaysOff: before + len(leave) + after,
+		})
+	}
+
+	sort.SliceStable(r, func(i, j int) bool {
+		ri, rj := r[i].Ratio(), r[j].Ratio()
+		if ri != rj {
+			return ri > rj
+		}
+		if len(r[i].Leave) != len(r[j].Leave) {
+			return len(r[i].Leave) < len(r[j].Leave)
+		}
+		return r[i].Start.Before(r[j].Start)
+	})
+	return r
+}
+
+// offBlock reports the last day and the length of the block of non-workdays
+// that starts at date and continues in the direction of add, and whether the
+// block includes a holiday that is a day off.
+func (c *BusinessCalendar) offBlock(date time.Time, add int) (last time.Time, n int, hol bool) {
+	// limit the search so calendars without workdays can't loop forever
+	for ; n < 366 && !c.IsWorkday(date); n++ {
+		if c.isDayOff(date) {
+			hol = true
+		}
+		last = date
+		date = date.AddDate(0, 0, add)
+	}
+	return last, n, hol
+}
@@ -0,0 +1,103 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBridgeDays(t *testing.T) {
+	ascension := &Holiday{Name: "Ascension", Offset: 39, Func: CalcEasterOffset}
+	assumption := &Holiday{Name: "Assumption", Month: time.August, Day: 15, Func: CalcDayOfMonth}
+	allSaints := &Holiday{Name: "All Saints", Month: time.November, Day: 1, Func: CalcDayOfMonth}
+
+	c := NewBusinessCalendar()
+	c.AddHoliday(ascension, assumption, allSaints)
+
+	sixDay := NewBusinessCalendar()
+	sixDay.SetWorkday(time.Saturday, true)
+	sixDay.AddHoliday(ascension)
+
+	type want struct {
+		leave   []time.Time
+		start   time.Time
+		end     time.Time
+		daysOff int
+	}
+	tests := []struct {
+		c        *BusinessCalendar
+		start    time.Time
+		end      time.Time
+		maxLeave int
+		want     []want
+	}{
+		// Ascension on Thursday 29-May-2025; Friday is a bridge day
+		{c, d(2025, 5, 1), d(2025, 6, 30), 1, []want{
+			{[]time.Time{d(2025, 5, 30)}, d(2025, 5, 29), d(2025, 6, 1), 4},
+		}},
+		// Tuesday 15-Aug-2023 and Wednesday 1-Nov-2023
+		{c, d(2023, 8, 1), d(2023, 11, 30), 2, []want{
+			{[]time.Time{d(2023, 8, 14)}, d(2023, 8, 12), d(2023, 8, 15), 4},
+			{[]time.Time{d(2023, 10, 30), d(2023, 10, 31)}, d(2023, 10, 28), d(2023, 11, 1), 5},
+			{[]time.Time{d(2023, 11, 2), d(2023, 11, 3)}, d(2023, 11, 1), d(2023, 11, 5), 5},
+		}},
+		{c, d(2023, 8, 1), d(2023, 11, 30), 0, nil},
+		// equal ratios prefer fewer leave days; runs without a holiday are ignored
+		{c, d(2022, 8, 1), d(2022, 11, 30), 5, []want{
+			{[]time.Time{d(2022, 10, 31)}, d(2022, 10, 29), d(2022, 11, 1), 4},
+			{[]time.Time{d(2022, 8, 16), d(2022, 8, 17), d(2022, 8, 18), d(2022, 8, 19)}, d(2022, 8, 13), d(2022, 8, 21), 9},
+			{[]time.Time{d(2022, 11, 2), d(2022, 11, 3), d(2022, 11, 4)}, d(2022, 11, 1), d(2022, 11, 6), 6},
+			{[]time.Time{d(2022, 8, 8), d(2022, 8, 9), d(2022, 8, 10), d(2022, 8, 11), d(2022, 8, 12)}, d(2022, 8, 6), d(2022, 8, 15), 10},
+		}},
+		// reversed range
+		{c, d(2025, 6, 30), d(2025, 5, 1), 1, []want{
+			{[]time.Time{d(2025, 5, 30)}, d(2025, 5, 29), d(2025, 6, 1), 4},
+		}},
+		// runs that extend outside of the range are ignored
+		{c, d(2025, 5, 1), d(2025, 5, 29), 1, nil},
+		// Saturday is a workday, so the bridge needs two days
+		{sixDay, d(2025, 5, 1), d(2025, 6, 30), 1, nil},
+		{sixDay, d(2025, 5, 1), d(2025, 6, 30), 2, []want{
+			{[]time.Time{d(2025, 5, 30), d(2025, 5, 31)}, d(2025, 5, 29), d(2025, 6, 1), 4},
+		}},
+	}
+
+	for i, test := range tests {
+		got := test.c.BridgeDays(test.start, test.end, test.maxLeave)
+		if len(got) != len(test.want) {
+			t.Errorf("[%d] got %d bridges: %v; want %d", i, len(got), got, len(test.want))
+			continue
+		}
+		for j, w := range test.want {
+			g := got[j]
+			if !g.Start.Equal(w.start) || !g.End.Equal(w.end) || g.DaysOff != w.daysOff || len(g.Leave) != len(w.leave) {
+				t.Errorf("[%d:%d] got: %v; want: %v", i, j, g, w)
+				continue
+			}
+			for k := range w.leave {
+				if !g.Leave[k].Equal(w.leave[k]) {
+					t.Errorf("[%d:%d] got leave: %v; want: %v", i, j, g.Leave, w.leave)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestBridgeRatio(t *testing.T) {
+	tests := []struct {
+		b    Bridge
+		want float64
+	}{
+		{Bridge{}, 0},
+		{Bridge{Leave: []time.Time{d(2025, 5, 30)}, DaysOff: 4}, 4},
+		{Bridge{Leave: []time.Time{d(2023, 10, 30), d(2023, 10, 31)}, DaysOff: 5}, 2.5},
+	}
+
+	for i, test := range tests {
+		if got := test.b.Ratio(); got != test.want {
+			t.Errorf("[%d] got: %f; want: %f", i, got, test.want)
+		}
+	}
+}
@@ -27,6 +27,22 @@ type WorkdayStartFn func(date time.Time) time.Time
 // is not necessary and you can use SetWorkingHours() instead.
 type WorkdayEndFn func(date time.Time) time.Time
 
+// WorkIntervalsFn reports the work intervals for the given date.
+// This is useful for situations where the working hours change by weekday or
+// throughout the year, or where some days have a different number of breaks.
+//
+// If your work intervals are the same every day then a WorkIntervalsFn is not
+// necessary and you can use SetWorkIntervals() instead.
+type WorkIntervalsFn func(date time.Time) []WorkInterval
+
+// HolidayPolicy selects which holidays make a day non-working in a
+// BusinessCalendar. The zero value makes every holiday a day off on its
+// observed date.
+type HolidayPolicy struct {
+	Types  []ObservanceType // holiday types that are days off; all types if empty
+	Actual bool             // holidays are days off on their actual date instead of their observed date
+}
+
 // BusinessCalendar represents a calendar used for business purposes.
 type BusinessCalendar struct {
 	workday     [7]bool   // flags to indicate a day of the week is a workday
@@ -37,6 +53,16 @@ type BusinessCalendar struct {
 	workdayEnd       time.Duration  // the time of day at which workdays end
 	WorkdayEndFunc   WorkdayEndFn   // optional function to override workday end time
 
+	workIntervals     []WorkInterval     // the periods of work in a day; overrides workdayStart/workdayEnd
+	Schedule          WorkSchedule       // optional work intervals for each weekday; overrides workIntervals
+	ScheduleOverrides []ScheduleOverride // optional work schedules for date ranges; overrides Schedule
+	WorkIntervalsFunc WorkIntervalsFn    // optional function to override work intervals
+
+	Policy HolidayPolicy // which holidays are days off
+
+	periodsFunc func(day time.Time) []Period // overrides all work hours, e.g. from member calendars
+	composite   *CompositeCalendar           // the composite calendar this calendar belongs to, if any
+
 	Calendar
 }
 
@@ -54,6 +80,19 @@ func NewBusinessCalendar() *BusinessCalendar {
 	return c
 }
 
+// New24x7BusinessCalendar creates a new BusinessCalendar with no holidays
+// defined where every day is a workday and every hour is working time. Only
+// holidays interrupt work time.
+func New24x7BusinessCalendar() *BusinessCalendar {
+	c := &BusinessCalendar{}
+	for i := range c.workday {
+		c.workday[i] = true
+	}
+	c.workdayStart = 0
+	c.workdayEnd = 24 * time.Hour
+	return c
+}
+
 // SetWorkday changes the given day's status as a standard working day
 func (c *BusinessCalendar) SetWorkday(day time.Weekday, workday bool) {
 	c.workday[day] = workday
@@ -61,11 +100,52 @@ func (c *BusinessCalendar) SetWorkday(day time.Weekday, workday bool) {
 
 // SetWorkHours sets the start and end times for a workday.
 //
-// Only hours and minutes will be considered. The time component of start
-// should be less than the time component of end.
+// The times are wall clock times measured from midnight, so a start of 9h is
+// always 9am, even on days with a daylight saving time transition. If end is
+// less than start, or greater than 24h, work continues past midnight into the
+// next day (see WorkInterval).
 func (c *BusinessCalendar) SetWorkHours(start time.Duration, end time.Duration) {
 	c.workdayStart = start
 	c.workdayEnd = end
+	c.workIntervals = nil
+}
+
+// SetWorkIntervals sets the periods of work in a workday, such as 8am-12pm and
+// 1pm-5pm for a workday with a lunch break.
+//
+// Overlapping intervals are merged. The workday starts at the earliest start
+// time and ends at the latest end time of the intervals.
+func (c *BusinessCalendar) SetWorkIntervals(intervals ...WorkInterval) {
+	c.workIntervals = append([]WorkInterval(nil), intervals...)
+	if len(intervals) == 0 {
+		c.workdayStart, c.workdayEnd = 0, 0
+		return
+	}
+	c.workdayStart, c.workdayEnd = intervals[0].Start, intervals[0].End
+	for _, iv := range intervals[1:] {
+		if iv.Start < c.workdayStart {
+			c.workdayStart = iv.Start
+		}
+		if iv.End > c.workdayEnd {
+			c.workdayEnd = iv.End
+		}
+	}
+}
+
+// SetWeekdayWorkHours sets the work intervals for the given day of the week,
+// overriding the hours set by SetWorkHours or SetWorkIntervals. Calling it
+// without intervals means there are no working hours on that day.
+//
+// This doesn't change whether the day is a workday; use SetWorkday for that.
+func (c *BusinessCalendar) SetWeekdayWorkHours(day time.Weekday, intervals ...WorkInterval) {
+	c.Schedule[day] = append([]WorkInterval{}, intervals...)
+}
+
+// AddScheduleOverride adds work schedules for date ranges, such as summer
+// hours. If the ranges of several overrides include a date, the override added
+// last is used.
+func (c *BusinessCalendar) AddScheduleOverride(o ...ScheduleOverride) {
+	c.ScheduleOverrides = append(c.ScheduleOverrides, o...)
 }
 
 // IsWorkday reports whether a given date is a work day (business day).
@@ -82,57 +162,32 @@ func (c *BusinessCalendar) IsWorkday(date time.Time) bool {
 		return false
 	}
 
-	_, obs, _ := c.IsHoliday(date)
-	return !obs
+	return !c.isDayOff(date)
 }
 
 // IsWorkTime reports whether a given date and time is within working hours.
+//
+// The start of each work interval is considered work time, as is the end of
+// intervals that finish on the day they start. Intervals that continue past
+// midnight end just before their end time, so 24h marks the end of the day.
+//
+// Work intervals that continue past midnight belong to the day on which they
+// start, so the early hours of a day can be work time even if the day itself
+// is not a workday.
 func (c *BusinessCalendar) IsWorkTime(date time.Time) bool {
-	if !c.IsWorkday(date) {
-		return false
-	}
-
-	var startHour int
-	var startMinute int
-	var endHour int
-	var endMinute int
-	var startSecond int
-	var endSecond int
-	if c.WorkdayStartFunc == nil {
-		startHour = int(c.workdayStart.Hours()) % 24
-		startMinute = int(c.workdayStart.Minutes()) % 60
-		startSecond = int(c.workdayStart.Seconds()) % 60
-	} else {
-		startTime := c.WorkdayStartFunc(date)
-		startHour = startTime.Hour()
-		startMinute = startTime.Minute()
-		startSecond = startTime.Second()
-	}
-	if c.WorkdayEndFunc == nil {
-		// Using 24h as "till the end of day" seems to be pretty common.
-		// By subtracting a second we get the desired behavior.
-		if c.workdayEnd == 24*time.Hour {
-			endHour = 23
-			endMinute = 59
-			endSecond = 59
-		} else {
-			endHour = int(c.workdayEnd.Hours()) % 24
-			endMinute = int(c.workdayEnd.Minutes()) % 60
-			endSecond = int(c.workdayEnd.Seconds()) % 60
+	date = c.in(date)
+	for _, day := range []time.Time{addDays(date, -1), date} {
+		for _, p := range c.dayPeriods(day) {
+			if date.Before(p.Start) || date.After(p.End) {
+				continue
+			}
+			if date.Equal(p.End) && p.End.Day() != p.Start.Day() {
+				continue
+			}
+			return true
 		}
-	} else {
-		endTime := c.WorkdayEndFunc(date)
-		endHour = endTime.Hour()
-		endMinute = endTime.Minute()
-		endSecond = endTime.Second()
 	}
-
-	h, m, s := date.Clock()
-	return (h == startHour && m == startMinute && s >= startSecond) ||
-		(h == startHour && m > startMinute) ||
-		(h > startHour && h < endHour) ||
-		(h == endHour && m < endMinute) ||
-		(h == endHour && m == endMinute && s <= endSecond)
+	return false
 }
 
 // WorkdaysRemain reports the total number of remaining workdays in the month
@@ -162,7 +217,8 @@ func (c *BusinessCalendar) WorkdaysInMonth(year int, month time.Month) int {
 }
 
 // HolidaysInRange reports the number of holidays between the start and end
-// times (inclusive).
+// times (inclusive). Only holidays that are days off according to the
+// calendar's Policy are counted.
 func (c *BusinessCalendar) HolidaysInRange(start, end time.Time) int {
 	factor := 1
 	if end.Before(start) {
@@ -172,8 +228,7 @@ func (c *BusinessCalendar) HolidaysInRange(start, end time.Time) int {
 	result := 0
 	to := DayStart(end)
 	for i := DayStart(start); i.Before(to) || i.Equal(to); i = i.AddDate(0, 0, 1) {
-		_, holiday, _ := c.IsHoliday(i)
-		if holiday {
+		if c.isDayOff(i) {
 			result++
 		}
 	}
@@ -266,120 +321,173 @@ func (c *BusinessCalendar) WorkdaysFrom(start time.Time, offset int) time.Time {
 }
 
 // WorkHours reports the number of working hours for the given day.
+//
+// The result is the time elapsed during the day's work intervals, so days
+// with a daylight saving time transition during working hours are an hour
+// longer or shorter than usual.
 func (c *BusinessCalendar) WorkHours(date time.Time) time.Duration {
+	date = c.in(date)
 	if !c.IsWorkday(date) {
 		return 0
 	}
 
-	var startHour int
-	var startMinute int
-	var endHour int
-	var endMinute int
-	if c.WorkdayStartFunc == nil {
-		startHour = int(c.workdayStart.Hours()) % 24
-		startMinute = int(c.workdayStart.Minutes()) % 60
-	} else {
-		startTime := c.WorkdayStartFunc(date)
-		startHour = startTime.Hour()
-		startMinute = startTime.Minute()
-	}
-	if c.WorkdayEndFunc == nil {
-		endHour = int(c.workdayEnd.Hours()) % 24
-		endMinute = int(c.workdayEnd.Minutes()) % 60
-	} else {
-		endTime := c.WorkdayEndFunc(date)
-		endHour = endTime.Hour()
-		endMinute = endTime.Minute()
+	r := time.Duration(0)
+	for _, p := range c.workPeriods(date) {
+		r += p.End.Sub(p.Start)
 	}
-
-	return (time.Duration(endHour)*time.Hour + time.Duration(endMinute)*time.Minute) -
-		(time.Duration(startHour)*time.Hour + time.Duration(startMinute)*time.Minute)
+	return r
 }
 
 // WorkdayStart reports the time at which work starts in the given day.
 // If the day is not a workday, the zero time is returned.
 func (c *BusinessCalendar) WorkdayStart(date time.Time) time.Time {
+	date = c.in(date)
 	if !c.IsWorkday(date) {
 		return time.Time{}
 	}
-
-	if c.WorkdayStartFunc == nil {
-		year, month, day := date.Date()
-		return time.Date(year, month, day, 0, 0, 0, 0, date.Location()).Add(c.workdayStart)
+	periods := c.workPeriods(date)
+	if len(periods) == 0 {
+		return time.Time{}
 	}
-	return c.WorkdayStartFunc(date)
+	return periods[0].Start
 }
 
 // WorkdayEnd reports the time at which work ends in the given day.
 // If the day is not a workday, the zero time is returned.
 func (c *BusinessCalendar) WorkdayEnd(date time.Time) time.Time {
+	date = c.in(date)
 	if !c.IsWorkday(date) {
 		return time.Time{}
 	}
-
-	if c.WorkdayEndFunc == nil {
-		year, month, day := date.Date()
-		return time.Date(year, month, day, 0, 0, 0, 0, date.Location()).Add(c.workdayEnd)
+	periods := c.workPeriods(date)
+	if len(periods) == 0 {
+		return time.Time{}
 	}
-	return c.WorkdayEndFunc(date)
+	return periods[len(periods)-1].End
 }
 
 // NextWorkdayStart reports the start of the next work day from the given date.
+// Workdays without working hours are skipped. If there are no working hours
+// within a year of the date, the zero time is returned.
 func (c *BusinessCalendar) NextWorkdayStart(date time.Time) time.Time {
-	t := date
-	if date.After(c.WorkdayStart(date)) {
-		t = t.Add(24 * time.Hour)
+	date = c.in(date)
+	// limit the search so calendars without working hours can't loop forever
+	for t, idle := date, 0; idle <= 366; t, idle = addDays(t, 1), idle+1 {
+		if start := c.WorkdayStart(t); !start.IsZero() && !start.Before(date) {
+			return start
+		}
 	}
+	return time.Time{}
+}
 
-	for !c.IsWorkday(t) {
-		t = t.Add(24 * time.Hour)
+// NextWorkdayEnd reports the end of the current or next work day from the
+// given date. Workdays without working hours are skipped. If there are no
+// working hours within a year of the date, the zero time is returned.
+func (c *BusinessCalendar) NextWorkdayEnd(date time.Time) time.Time {
+	date = c.in(date)
+	// the previous day's work may continue past midnight
+	if end := c.WorkdayEnd(addDays(date, -1)); end.After(date) {
+		return end
 	}
-	return c.WorkdayStart(t)
+
+	// limit the search so calendars without working hours can't loop forever
+	for t, idle := date, 0; idle <= 366; t, idle = addDays(t, 1), idle+1 {
+		if end := c.WorkdayEnd(t); !end.IsZero() && !end.Before(date) {
+			return end
+		}
+	}
+	return time.Time{}
 }
 
-// NextWorkdayEnd reports the end of the current or next work day from the given date.
-func (c *BusinessCalendar) NextWorkdayEnd(date time.Time) time.Time {
-	t := date
-	if date.After(c.WorkdayEnd(date)) {
-		t = t.Add(24 * time.Hour)
+// PrevWorkdayStart reports the start of the current or previous work day from
+// the given date. If there are no working hours within a year of the date, the
+// zero time is returned.
+func (c *BusinessCalendar) PrevWorkdayStart(date time.Time) time.Time {
+	date = c.in(date)
+	// limit the search so calendars without working hours can't loop forever
+	for t, idle := date, 0; idle <= 366; t, idle = addDays(t, -1), idle+1 {
+		if start := c.WorkdayStart(t); !start.IsZero() && !start.After(date) {
+			return start
+		}
 	}
+	return time.Time{}
+}
 
-	for !c.IsWorkday(t) {
-		t = t.Add(24 * time.Hour)
+// PrevWorkdayEnd reports the end of the previous work day from the given date.
+// If work has already ended on the given date, the end of that day's work is
+// returned. If there are no working hours within a year of the date, the zero
+// time is returned.
+func (c *BusinessCalendar) PrevWorkdayEnd(date time.Time) time.Time {
+	date = c.in(date)
+	// limit the search so calendars without working hours can't loop forever
+	for t, idle := date, 0; idle <= 366; t, idle = addDays(t, -1), idle+1 {
+		if end := c.WorkdayEnd(t); !end.IsZero() && !end.After(date) {
+			return end
+		}
 	}
-	return c.WorkdayEnd(t)
+	return time.Time{}
 }
 
 // WorkHoursInRange reports the working hours between the given start and end
 // dates.
 func (c *BusinessCalendar) WorkHoursInRange(start, end time.Time) time.Duration {
 	r := time.Duration(0)
+	for _, p := range c.WorkPeriods(start, end) {
+		r += p.Duration()
+	}
+	return r
+}
+
+// WorkPeriods reports the periods of working time between the given start and
+// end dates in ascending order. Periods are clipped to the range, and periods
+// that overlap or follow each other without a break are joined.
+//
+// Weekends, holidays and the time outside of working hours are skipped.
+func (c *BusinessCalendar) WorkPeriods(start, end time.Time) []Period {
+	start, end = c.in(start), c.in(end)
 	if end.Before(start) {
 		start, end = end, start
 	}
 
-	var current time.Time
-	if c.IsWorkTime(start) {
-		current = start
-	} else {
-		dayStart := c.WorkdayStart(start)
-		if dayStart.IsZero() {
-			current = c.NextWorkdayStart(start)
-		} else {
-			dayEnd := c.WorkdayEnd(start)
-			if start.After(dayEnd) {
-				current = c.NextWorkdayStart(start)
-			} else {
-				current = dayStart
+	// start a day early to include intervals that continue past midnight;
+	// intervals are visited in order of their start time so the cursor
+	// prevents overlapping intervals from being counted twice
+	var r []Period
+	cursor := start
+	for day := DayStart(addDays(start, -1)); !day.After(end); day = DayStart(addDays(day, 1)) {
+		for _, p := range c.dayPeriods(day) {
+			from, until := MaxTime(p.Start, cursor), MinTime(p.End, end)
+			if until.After(from) {
+				if n := len(r); n > 0 && r[n-1].End.Equal(from) {
+					r[n-1].End = until
+				} else {
+					r = append(r, Period{from, until})
+				}
 			}
+			cursor = MaxTime(cursor, p.End)
 		}
 	}
+	return r
+}
 
-	for current.Before(end) {
-		dayEnd := c.WorkdayEnd(current)
-		last := MinTime(dayEnd, end)
-		r += last.Sub(current)
-		current = c.NextWorkdayStart(last)
+// OffPeriods reports the periods of non-working time between the given start
+// and end dates in ascending order. This is the inverse of WorkPeriods.
+func (c *BusinessCalendar) OffPeriods(start, end time.Time) []Period {
+	start, end = c.in(start), c.in(end)
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var r []Period
+	cursor := start
+	for _, p := range c.WorkPeriods(start, end) {
+		if p.Start.After(cursor) {
+			r = append(r, Period{cursor, p.Start})
+		}
+		cursor = p.End
+	}
+	if end.After(cursor) {
+		r = append(r, Period{cursor, end})
 	}
 	return r
 }
@@ -387,31 +495,192 @@ func (c *BusinessCalendar) WorkHoursInRange(start, end time.Time) time.Duration
 // AddWorkHours determines the time in the future where the worked hours will
 // be completed.
 //
-// If duration <= 0, then the original date is returned.
+// If worked == 0, then the original date is returned. If worked < 0, then the
+// result is the same as SubWorkHours(date, -worked). If there are no working
+// hours within a year of the date, the zero time is returned.
 func (c *BusinessCalendar) AddWorkHours(date time.Time, worked time.Duration) time.Time {
-	if worked <= 0 {
+	if worked == 0 {
 		return date
+	} else if worked < 0 {
+		return c.SubWorkHours(date, -worked)
+	}
+
+	date = c.in(date)
+	cursor := date
+	// limit the search so calendars without working hours can't loop forever
+	for day, idle := addDays(date, -1), 0; idle <= 366; day = addDays(day, 1) {
+		idle++
+		for _, p := range c.dayPeriods(day) {
+			from := MaxTime(p.Start, cursor)
+			if !p.End.After(from) {
+				continue
+			}
+			idle = 0
+			if avail := p.End.Sub(from); worked > avail {
+				worked -= avail
+				cursor = p.End
+				continue
+			}
+			return from.Add(worked)
+		}
 	}
+	return time.Time{}
+}
 
-	start := date
-	if !c.IsWorkday(start) {
-		start = c.NextWorkdayStart(start)
-	} else if !c.IsWorkTime(start) {
-		dayStart := c.WorkdayStart(start)
-		dayEnd := c.WorkdayEnd(start)
-		if start.Before(dayStart) {
-			start = dayStart
-		} else if start.After(dayEnd) {
-			start = c.NextWorkdayStart(start)
+// SubWorkHours determines the time in the past where the worked hours would
+// have started in order to be completed at the given date.
+//
+// If worked == 0, then the original date is returned. If worked < 0, then the
+// result is the same as AddWorkHours(date, -worked). If there are no working
+// hours within a year of the date, the zero time is returned.
+func (c *BusinessCalendar) SubWorkHours(date time.Time, worked time.Duration) time.Time {
+	if worked == 0 {
+		return date
+	} else if worked < 0 {
+		return c.AddWorkHours(date, -worked)
+	}
+
+	date = c.in(date)
+	cursor := date
+	// limit the search so calendars without working hours can't loop forever
+	for day, idle := date, 0; idle <= 366; day = addDays(day, -1) {
+		idle++
+		periods := c.dayPeriods(day)
+		for i := len(periods) - 1; i >= 0; i-- {
+			p := periods[i]
+			until := MinTime(p.End, cursor)
+			if !until.After(p.Start) {
+				continue
+			}
+			idle = 0
+			if avail := until.Sub(p.Start); worked > avail {
+				worked -= avail
+				cursor = p.Start
+				continue
+			}
+			return until.Add(-worked)
 		}
 	}
+	return time.Time{}
+}
 
-	var r time.Time
-	for worked > 0 {
-		dayEnd := c.WorkdayEnd(start)
-		r = MinTime(start.Add(worked), dayEnd)
-		worked -= c.WorkHoursInRange(start, r)
-		start = c.NextWorkdayStart(dayEnd)
+// isDayOff reports whether a holiday makes the given date a day off according
+// to the calendar's Policy.
+func (c *BusinessCalendar) isDayOff(date time.Time) bool {
+	if len(c.Policy.Types) == 0 && !c.Policy.Actual {
+		_, obs, _ := c.IsHoliday(date)
+		return obs
 	}
-	return r
+
+	year, month, day := date.Date()
+	if c.isApplicable(date.Location(), year) {
+		for _, h := range c.Holidays {
+			if act, obs := c.occursOn(h, year, month, day); c.Policy.matches(h, act, obs) {
+				return true
+			}
+		}
+	}
+	if c.holidayFunc != nil {
+		act, obs, h := c.holidayFunc(date)
+		return h != nil && c.Policy.matches(h, act, obs)
+	}
+	return false
+}
+
+// matches reports whether the policy makes a day off of a holiday that
+// occurs or is observed on the day as given.
+func (p *HolidayPolicy) matches(h *Holiday, actual, observed bool) bool {
+	if (p.Actual && !actual) || (!p.Actual && !observed) {
+		return false
+	}
+	if len(p.Types) == 0 {
+		return true
+	}
+	for _, t := range p.Types {
+		if h.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// in reports the given time in the calendar's location. If the calendar has no
+// location, the time is returned unchanged.
+func (c *BusinessCalendar) in(t time.Time) time.Time {
+	if c.Location == nil {
+		return t
+	}
+	return t.In(c.Location)
+}
+
+// dayPeriods reports the periods of work that start in the given day, or nil
+// if the day is not a workday.
+func (c *BusinessCalendar) dayPeriods(day time.Time) []Period {
+	if !c.IsWorkday(day) {
+		return nil
+	}
+	return c.workPeriods(day)
+}
+
+// intervals reports the work intervals for the given day without checking
+// whether the day is a workday.
+func (c *BusinessCalendar) intervals(date time.Time) []WorkInterval {
+	if c.WorkIntervalsFunc != nil {
+		return c.WorkIntervalsFunc(date)
+	}
+	for i := len(c.ScheduleOverrides) - 1; i >= 0; i-- {
+		o := &c.ScheduleOverrides[i]
+		if o.Contains(date) && o.Schedule[date.Weekday()] != nil {
+			return o.Schedule[date.Weekday()]
+		}
+	}
+	if iv := c.Schedule[date.Weekday()]; iv != nil {
+		return iv
+	}
+	if c.workIntervals != nil {
+		return c.workIntervals
+	}
+	return []WorkInterval{{c.workdayStart, c.workdayEnd}}
+}
+
+// workPeriods reports the periods of work in the given day in ascending order
+// without checking whether the day is a workday. Overlapping periods are
+// merged.
+func (c *BusinessCalendar) workPeriods(date time.Time) []Period {
+	if c.periodsFunc != nil {
+		return c.periodsFunc(date)
+	}
+
+	var r []Period
+	if c.WorkdayStartFunc != nil || c.WorkdayEndFunc != nil {
+		p := Period{wallClock(date, c.workdayStart), wallClock(date, c.workdayEnd)}
+		if c.WorkdayStartFunc != nil {
+			p.Start = c.WorkdayStartFunc(date)
+		}
+		if c.WorkdayEndFunc != nil {
+			p.End = c.WorkdayEndFunc(date)
+		}
+		if p.End.Before(p.Start) {
+			p.End = p.End.AddDate(0, 0, 1)
+		}
+		r = append(r, p)
+	} else {
+		intervals := c.intervals(date)
+		for _, iv := range intervals {
+			end := iv.End
+			if end < iv.Start {
+				end += 24 * time.Hour
+			}
+			r = append(r, Period{wallClock(date, iv.Start), wallClock(date, end)})
+		}
+	}
+	return mergePeriods(r)
+}
+
+// addDays reports noon of the day that is n days from date in date's
+// location. Noon is used so that the result is always on the expected day,
+// regardless of daylight saving time transitions.
+func addDays(date time.Time, n int) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day+n, 12, 0, 0, 0, date.Location())
 }
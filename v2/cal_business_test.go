@@ -3,6 +3,7 @@
 package cal
 
 import (
+	"reflect"
 	"testing"
 	"time"
 )
@@ -509,6 +510,15 @@ func TestNextWorkdayStart(t *testing.T) {
 		return time.Date(date.Year(), date.Month(), date.Day(), date.Day()%12+6, 45, 0, 0, time.UTC)
 	}
 
+	// no working hours on Wednesdays
+	cal3 := NewBusinessCalendar()
+	cal3.Schedule = WorkSchedule{time.Wednesday: {}}
+	closed := NewBusinessCalendar()
+	closed.Schedule = WorkSchedule{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		closed.Schedule[day] = []WorkInterval{}
+	}
+
 	tests := []struct {
 		c    *BusinessCalendar
 		d    time.Time
@@ -521,6 +531,11 @@ func TestNextWorkdayStart(t *testing.T) {
 
 		{cal2, dt(2020, 4, 1, 3, 0), dt(2020, 4, 2, 2, 30)},
 		{cal2, dt(2020, 4, 6, 8, 0), dt(2020, 4, 7, 7, 30)},
+
+		{cal3, dt(2020, 3, 31, 20, 0), dt(2020, 4, 2, 9, 0)},
+		{cal3, dt(2020, 4, 1, 6, 0), dt(2020, 4, 2, 9, 0)},
+		{cal3, dt(2020, 3, 31, 6, 0), dt(2020, 3, 31, 9, 0)},
+		{closed, dt(2020, 4, 1, 6, 0), time.Time{}},
 	}
 
 	for i, test := range tests {
@@ -541,6 +556,15 @@ func TestNextWorkdayEnd(t *testing.T) {
 		return time.Date(date.Year(), date.Month(), date.Day(), date.Day()%12+6, 45, 0, 0, time.UTC)
 	}
 
+	// no working hours on Wednesdays
+	cal3 := NewBusinessCalendar()
+	cal3.Schedule = WorkSchedule{time.Wednesday: {}}
+	closed := NewBusinessCalendar()
+	closed.Schedule = WorkSchedule{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		closed.Schedule[day] = []WorkInterval{}
+	}
+
 	tests := []struct {
 		c    *BusinessCalendar
 		d    time.Time
@@ -554,6 +578,11 @@ func TestNextWorkdayEnd(t *testing.T) {
 		{cal2, dt(2020, 4, 1, 3, 0), dt(2020, 4, 1, 7, 45)},
 		{cal2, dt(2020, 4, 6, 8, 0), dt(2020, 4, 6, 12, 45)},
 		{cal2, dt(2020, 4, 6, 22, 0), dt(2020, 4, 7, 13, 45)},
+
+		{cal3, dt(2020, 3, 31, 20, 0), dt(2020, 4, 2, 17, 0)},
+		{cal3, dt(2020, 4, 1, 6, 0), dt(2020, 4, 2, 17, 0)},
+		{cal3, dt(2020, 3, 31, 6, 0), dt(2020, 3, 31, 17, 0)},
+		{closed, dt(2020, 4, 1, 6, 0), time.Time{}},
 	}
 
 	for i, test := range tests {
@@ -637,3 +666,774 @@ func TestAddWorkHours(t *testing.T) {
 		}
 	}
 }
+
+func TestWorkHoursDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	sydney, _ := time.LoadLocation("Australia/Sydney")
+	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+
+	// every day is a workday so that transitions on Sundays are covered
+	daily := func(start, end time.Duration) *BusinessCalendar {
+		c := NewBusinessCalendar()
+		for i := time.Sunday; i <= time.Saturday; i++ {
+			c.SetWorkday(i, true)
+		}
+		c.SetWorkHours(start, end)
+		return c
+	}
+	office := daily(9*time.Hour, 17*time.Hour)
+	night := daily(1*time.Hour, 5*time.Hour)
+	allDay := daily(0, 24*time.Hour)
+
+	at := func(loc *time.Location, y, m, d, h, min int) time.Time {
+		return time.Date(y, time.Month(m), d, h, min, 0, 0, loc)
+	}
+
+	hourTests := []struct {
+		c    *BusinessCalendar
+		d    time.Time
+		want time.Duration
+	}{
+		// US: spring forward 14 Mar 2021, fall back 7 Nov 2021 at 2am
+		{office, at(ny, 2021, 3, 14, 12, 0), 8 * time.Hour},
+		{night, at(ny, 2021, 3, 14, 12, 0), 3 * time.Hour},
+		{night, at(ny, 2021, 11, 7, 12, 0), 5 * time.Hour},
+		{allDay, at(ny, 2021, 3, 14, 12, 0), 23 * time.Hour},
+		{allDay, at(ny, 2021, 11, 7, 12, 0), 25 * time.Hour},
+		{allDay, at(ny, 2021, 11, 8, 12, 0), 24 * time.Hour},
+
+		// EU: spring forward 28 Mar 2021 at 2am, fall back 31 Oct 2021 at 3am
+		{office, at(berlin, 2021, 3, 28, 12, 0), 8 * time.Hour},
+		{night, at(berlin, 2021, 3, 28, 12, 0), 3 * time.Hour},
+		{night, at(berlin, 2021, 10, 31, 12, 0), 5 * time.Hour},
+		{allDay, at(berlin, 2021, 3, 28, 12, 0), 23 * time.Hour},
+		{allDay, at(berlin, 2021, 10, 31, 12, 0), 25 * time.Hour},
+
+		// Australia: fall back 4 Apr 2021 at 3am, spring forward 3 Oct 2021 at 2am
+		{office, at(sydney, 2021, 10, 3, 12, 0), 8 * time.Hour},
+		{night, at(sydney, 2021, 4, 4, 12, 0), 5 * time.Hour},
+		{night, at(sydney, 2021, 10, 3, 12, 0), 3 * time.Hour},
+		{allDay, at(sydney, 2021, 4, 4, 12, 0), 25 * time.Hour},
+		{allDay, at(sydney, 2021, 10, 3, 12, 0), 23 * time.Hour},
+
+		// Brazil: spring forward 4 Nov 2018 at midnight, fall back 17 Feb 2019 at midnight
+		{office, at(saoPaulo, 2018, 11, 4, 12, 0), 8 * time.Hour},
+		{allDay, at(saoPaulo, 2018, 11, 4, 12, 0), 23 * time.Hour},
+		{allDay, at(saoPaulo, 2019, 2, 16, 12, 0), 25 * time.Hour},
+	}
+
+	for i, test := range hourTests {
+		got := test.c.WorkHours(test.d)
+		if got != test.want {
+			t.Errorf("[%d] WorkHours got: %s; want: %s (%s)", i, got, test.want, test.d)
+		}
+	}
+
+	timeTests := []struct {
+		name string
+		fn   func(time.Time) time.Time
+		d    time.Time
+		want time.Time
+	}{
+		{"WorkdayStart", office.WorkdayStart, at(ny, 2021, 3, 14, 1, 0), at(ny, 2021, 3, 14, 9, 0)},
+		{"WorkdayStart", office.WorkdayStart, at(ny, 2021, 11, 7, 1, 0), at(ny, 2021, 11, 7, 9, 0)},
+		{"WorkdayEnd", office.WorkdayEnd, at(ny, 2021, 11, 7, 1, 0), at(ny, 2021, 11, 7, 17, 0)},
+		{"WorkdayStart", office.WorkdayStart, at(berlin, 2021, 3, 28, 12, 0), at(berlin, 2021, 3, 28, 9, 0)},
+		{"WorkdayStart", office.WorkdayStart, at(sydney, 2021, 10, 3, 12, 0), at(sydney, 2021, 10, 3, 9, 0)},
+		{"WorkdayStart", office.WorkdayStart, at(saoPaulo, 2018, 11, 4, 12, 0), at(saoPaulo, 2018, 11, 4, 9, 0)},
+		{"WorkdayStart", allDay.WorkdayStart, at(saoPaulo, 2018, 11, 4, 12, 0), at(saoPaulo, 2018, 11, 4, 1, 0)},
+		{"WorkdayEnd", allDay.WorkdayEnd, at(saoPaulo, 2018, 11, 3, 12, 0), at(saoPaulo, 2018, 11, 4, 1, 0)},
+
+		// stepping over a short day must not skip the following day
+		{"NextWorkdayStart", office.NextWorkdayStart, at(ny, 2021, 3, 13, 23, 30), at(ny, 2021, 3, 14, 9, 0)},
+		{"NextWorkdayStart", office.NextWorkdayStart, at(berlin, 2021, 3, 27, 23, 30), at(berlin, 2021, 3, 28, 9, 0)},
+		{"NextWorkdayEnd", office.NextWorkdayEnd, at(sydney, 2021, 10, 2, 23, 30), at(sydney, 2021, 10, 3, 17, 0)},
+		{"NextWorkdayStart", office.NextWorkdayStart, at(saoPaulo, 2018, 11, 3, 23, 30), at(saoPaulo, 2018, 11, 4, 9, 0)},
+		{"NextWorkdayStart", office.NextWorkdayStart, at(ny, 2021, 11, 6, 23, 30), at(ny, 2021, 11, 7, 9, 0)},
+
+		// elapsed working time includes the extra or missing hour
+		{"AddWorkHours", func(t time.Time) time.Time { return allDay.AddWorkHours(t, 24*time.Hour) },
+			at(ny, 2021, 11, 6, 12, 0), at(ny, 2021, 11, 7, 11, 0)},
+		{"AddWorkHours", func(t time.Time) time.Time { return allDay.AddWorkHours(t, 24*time.Hour) },
+			at(berlin, 2021, 3, 27, 12, 0), at(berlin, 2021, 3, 28, 13, 0)},
+		{"AddWorkHours", func(t time.Time) time.Time { return night.AddWorkHours(t, 4*time.Hour) },
+			at(sydney, 2021, 10, 3, 1, 0), at(sydney, 2021, 10, 4, 2, 0)},
+		{"AddWorkHours", func(t time.Time) time.Time { return office.AddWorkHours(t, 8*time.Hour) },
+			at(ny, 2021, 3, 14, 9, 0), at(ny, 2021, 3, 14, 17, 0)},
+	}
+
+	for i, test := range timeTests {
+		got := test.fn(test.d)
+		if !got.Equal(test.want) {
+			t.Errorf("[%d] %s got: %s; want: %s (%s)", i, test.name, got, test.want, test.d)
+		}
+	}
+
+	rangeTests := []struct {
+		c          *BusinessCalendar
+		start, end time.Time
+		want       time.Duration
+	}{
+		{allDay, at(ny, 2021, 3, 13, 0, 0), at(ny, 2021, 3, 15, 0, 0), 47 * time.Hour},
+		{allDay, at(berlin, 2021, 10, 30, 0, 0), at(berlin, 2021, 11, 1, 0, 0), 49 * time.Hour},
+		{office, at(sydney, 2021, 10, 2, 0, 0), at(sydney, 2021, 10, 5, 0, 0), 3 * 8 * time.Hour},
+		{night, at(sydney, 2021, 4, 3, 0, 0), at(sydney, 2021, 4, 5, 0, 0), 4*time.Hour + 5*time.Hour},
+		{office, at(saoPaulo, 2018, 11, 3, 0, 0), at(saoPaulo, 2018, 11, 5, 0, 0), 2 * 8 * time.Hour},
+	}
+
+	for i, test := range rangeTests {
+		got := test.c.WorkHoursInRange(test.start, test.end)
+		if got != test.want {
+			t.Errorf("[%d] WorkHoursInRange got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	hol := &Holiday{Month: time.November, Day: 4, Func: CalcDayOfMonth}
+	if got := DayStart(at(saoPaulo, 2018, 11, 4, 12, 0)); !got.Equal(at(saoPaulo, 2018, 11, 4, 1, 0)) {
+		t.Errorf("DayStart got: %s; want: 2018-11-04 01:00", got)
+	}
+	if act, _ := hol.CalcIn(2018, saoPaulo); act.Day() != 4 {
+		t.Errorf("CalcIn got: %s; want: 2018-11-04", act)
+	}
+}
+
+func TestWorkHoursLocation(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+
+	c := NewBusinessCalendar()
+	c.Location = ny
+
+	// 2021-03-15 13:30 UTC is 09:30 in New York
+	date := time.Date(2021, 3, 15, 13, 30, 0, 0, time.UTC)
+	if !c.IsWorkTime(date) {
+		t.Errorf("expected work time for %s", date)
+	}
+	if got, want := c.WorkdayStart(date), time.Date(2021, 3, 15, 9, 0, 0, 0, ny); !got.Equal(want) || got.Location() != ny {
+		t.Errorf("WorkdayStart got: %s; want: %s", got, want)
+	}
+	// 2021-03-16 02:00 UTC is still 15 Mar in New York
+	date = time.Date(2021, 3, 16, 2, 0, 0, 0, time.UTC)
+	if got, want := c.NextWorkdayStart(date), time.Date(2021, 3, 16, 9, 0, 0, 0, ny); !got.Equal(want) {
+		t.Errorf("NextWorkdayStart got: %s; want: %s", got, want)
+	}
+}
+
+func TestWorkIntervals(t *testing.T) {
+	cal1 := NewBusinessCalendar()
+	cal1.SetWorkIntervals(
+		WorkInterval{Start: 13 * time.Hour, End: 17 * time.Hour},
+		WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour},
+	)
+	cal2 := NewBusinessCalendar()
+	cal2.SetWorkIntervals(
+		WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour},
+		WorkInterval{Start: 10 * time.Hour, End: 14 * time.Hour},
+	)
+	cal3 := NewBusinessCalendar()
+	cal3.SetWorkIntervals(WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour})
+	cal3.WorkIntervalsFunc = func(date time.Time) []WorkInterval {
+		if date.Weekday() == time.Friday {
+			return []WorkInterval{{Start: 8 * time.Hour, End: 12 * time.Hour}}
+		}
+		return []WorkInterval{
+			{Start: 8 * time.Hour, End: 12 * time.Hour},
+			{Start: 13 * time.Hour, End: 15 * time.Hour},
+			{Start: 16 * time.Hour, End: 18 * time.Hour},
+		}
+	}
+
+	workTimeTests := []struct {
+		c    *BusinessCalendar
+		d    time.Time
+		want bool
+	}{
+		{cal1, dt(2020, 4, 1, 7, 59), false},
+		{cal1, dt(2020, 4, 1, 8, 0), true},
+		{cal1, dt(2020, 4, 1, 12, 0), true},
+		{cal1, dt(2020, 4, 1, 12, 30), false},
+		{cal1, dt(2020, 4, 1, 13, 0), true},
+		{cal1, dt(2020, 4, 1, 17, 1), false},
+		{cal2, dt(2020, 4, 1, 11, 0), true},
+		{cal2, dt(2020, 4, 1, 13, 0), true},
+		{cal3, dt(2020, 4, 1, 15, 30), false},
+		{cal3, dt(2020, 4, 1, 17, 0), true},
+		{cal3, dt(2020, 4, 3, 14, 0), false},
+	}
+
+	for i, test := range workTimeTests {
+		got := test.c.IsWorkTime(test.d)
+		if got != test.want {
+			t.Errorf("[%d] IsWorkTime got: %t; want: %t", i, got, test.want)
+		}
+	}
+
+	hourTests := []struct {
+		c    *BusinessCalendar
+		d    time.Time
+		want time.Duration
+	}{
+		{cal1, d(2020, 4, 1), 8 * time.Hour},
+		{cal1, d(2020, 4, 4), 0},
+		{cal2, d(2020, 4, 1), 6 * time.Hour},
+		{cal3, d(2020, 4, 2), 8 * time.Hour},
+		{cal3, d(2020, 4, 3), 4 * time.Hour},
+	}
+
+	for i, test := range hourTests {
+		got := test.c.WorkHours(test.d)
+		if got != test.want {
+			t.Errorf("[%d] WorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	if got, want := cal1.WorkdayStart(dt(2020, 4, 1, 12, 0)), dt(2020, 4, 1, 8, 0); got != want {
+		t.Errorf("WorkdayStart got: %s; want: %s", got, want)
+	}
+	if got, want := cal1.WorkdayEnd(dt(2020, 4, 1, 12, 0)), dt(2020, 4, 1, 17, 0); got != want {
+		t.Errorf("WorkdayEnd got: %s; want: %s", got, want)
+	}
+	if got, want := cal3.WorkdayEnd(dt(2020, 4, 3, 12, 0)), dt(2020, 4, 3, 12, 0); got != want {
+		t.Errorf("WorkdayEnd got: %s; want: %s", got, want)
+	}
+
+	rangeTests := []struct {
+		c    *BusinessCalendar
+		f    time.Time
+		t    time.Time
+		want time.Duration
+	}{
+		{cal1, dt(2020, 4, 1, 0, 0), dt(2020, 4, 2, 0, 0), 8 * time.Hour},
+		{cal1, dt(2020, 4, 1, 11, 0), dt(2020, 4, 1, 14, 0), 2 * time.Hour},
+		{cal1, dt(2020, 4, 1, 12, 15), dt(2020, 4, 1, 12, 45), 0},
+		{cal1, dt(2020, 4, 1, 16, 0), dt(2020, 4, 2, 9, 0), 2 * time.Hour},
+		{cal1, dt(2020, 4, 3, 16, 0), dt(2020, 4, 6, 14, 0), 6 * time.Hour},
+		{cal3, dt(2020, 4, 2, 0, 0), dt(2020, 4, 4, 0, 0), 12 * time.Hour},
+	}
+
+	for i, test := range rangeTests {
+		got := test.c.WorkHoursInRange(test.f, test.t)
+		if got != test.want {
+			t.Errorf("[%d] WorkHoursInRange got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	addTests := []struct {
+		c    *BusinessCalendar
+		t    time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{cal1, dt(2020, 4, 1, 11, 0), 2 * time.Hour, dt(2020, 4, 1, 14, 0)},
+		{cal1, dt(2020, 4, 1, 11, 0), 1 * time.Hour, dt(2020, 4, 1, 12, 0)},
+		{cal1, dt(2020, 4, 1, 12, 30), 30 * time.Minute, dt(2020, 4, 1, 13, 30)},
+		{cal1, dt(2020, 4, 1, 16, 0), 2 * time.Hour, dt(2020, 4, 2, 9, 0)},
+		{cal1, dt(2020, 4, 3, 16, 0), 6 * time.Hour, dt(2020, 4, 6, 14, 0)},
+		{cal1, dt(2020, 4, 1, 8, 0), 8 * time.Hour, dt(2020, 4, 1, 17, 0)},
+		{cal3, dt(2020, 4, 2, 14, 0), 4 * time.Hour, dt(2020, 4, 3, 9, 0)},
+	}
+
+	for i, test := range addTests {
+		got := test.c.AddWorkHours(test.t, test.d)
+		if got != test.want {
+			t.Errorf("[%d] AddWorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	empty := NewBusinessCalendar()
+	empty.SetWorkIntervals()
+	if got := empty.AddWorkHours(dt(2020, 4, 1, 8, 0), time.Hour); !got.IsZero() {
+		t.Errorf("AddWorkHours got: %s; want zero time", got)
+	}
+	if got := empty.WorkdayStart(d(2020, 4, 1)); !got.IsZero() {
+		t.Errorf("WorkdayStart got: %s; want zero time", got)
+	}
+	if got := empty.WorkdayEnd(d(2020, 4, 1)); !got.IsZero() {
+		t.Errorf("WorkdayEnd got: %s; want zero time", got)
+	}
+}
+
+func TestOvernightWorkHours(t *testing.T) {
+	hol := &Holiday{Month: time.April, Day: 8, Func: CalcDayOfMonth}
+
+	cal1 := NewBusinessCalendar()
+	cal1.SetWorkHours(22*time.Hour, 6*time.Hour)
+	cal1.AddHoliday(hol)
+	cal2 := NewBusinessCalendar()
+	cal2.SetWorkIntervals(WorkInterval{Start: 22 * time.Hour, End: 30 * time.Hour})
+	cal2.AddHoliday(hol)
+	cal3 := New24x7BusinessCalendar()
+	cal3.AddHoliday(hol)
+
+	workTimeTests := []struct {
+		c    *BusinessCalendar
+		d    time.Time
+		want bool
+	}{
+		{cal1, dt(2020, 4, 1, 21, 59), false},
+		{cal1, dt(2020, 4, 1, 22, 0), true},
+		{cal1, dt(2020, 4, 2, 3, 0), true},
+		{cal1, dt(2020, 4, 2, 6, 0), false},
+		{cal1, dt(2020, 4, 2, 12, 0), false},
+		{cal1, dt(2020, 4, 4, 3, 0), true},   // Friday's shift continues on Saturday
+		{cal1, dt(2020, 4, 4, 23, 0), false}, // no shift starts on Saturday
+		{cal1, dt(2020, 4, 6, 3, 0), false},  // or Sunday
+		{cal1, dt(2020, 4, 8, 3, 0), true},   // Tuesday's shift continues on the holiday
+		{cal1, dt(2020, 4, 8, 23, 0), false}, // no shift starts on the holiday
+		{cal1, dt(2020, 4, 9, 3, 0), false},
+		{cal2, dt(2020, 4, 2, 3, 0), true},
+		{cal2, dt(2020, 4, 4, 3, 0), true},
+
+		{cal3, dt(2020, 4, 4, 0, 0), true},
+		{cal3, dt(2020, 4, 5, 12, 0), true},
+		{cal3, dt(2020, 4, 7, 23, 59), true},
+		{cal3, dt(2020, 4, 8, 0, 0), false},
+		{cal3, dt(2020, 4, 8, 12, 0), false},
+		{cal3, dt(2020, 4, 9, 0, 0), true},
+	}
+
+	for i, test := range workTimeTests {
+		got := test.c.IsWorkTime(test.d)
+		if got != test.want {
+			t.Errorf("[%d] IsWorkTime got: %t; want: %t", i, got, test.want)
+		}
+	}
+
+	hourTests := []struct {
+		c    *BusinessCalendar
+		d    time.Time
+		want time.Duration
+	}{
+		{cal1, d(2020, 4, 1), 8 * time.Hour},
+		{cal1, d(2020, 4, 4), 0},
+		{cal1, d(2020, 4, 8), 0},
+		{cal2, d(2020, 4, 1), 8 * time.Hour},
+		{cal3, d(2020, 4, 4), 24 * time.Hour},
+		{cal3, d(2020, 4, 8), 0},
+	}
+
+	for i, test := range hourTests {
+		got := test.c.WorkHours(test.d)
+		if got != test.want {
+			t.Errorf("[%d] WorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	timeTests := []struct {
+		name string
+		fn   func(time.Time) time.Time
+		d    time.Time
+		want time.Time
+	}{
+		{"WorkdayStart", cal1.WorkdayStart, dt(2020, 4, 1, 12, 0), dt(2020, 4, 1, 22, 0)},
+		{"WorkdayEnd", cal1.WorkdayEnd, dt(2020, 4, 1, 12, 0), dt(2020, 4, 2, 6, 0)},
+		{"NextWorkdayStart", cal1.NextWorkdayStart, dt(2020, 4, 3, 23, 0), dt(2020, 4, 6, 22, 0)},
+		{"NextWorkdayEnd", cal1.NextWorkdayEnd, dt(2020, 4, 4, 3, 0), dt(2020, 4, 4, 6, 0)},
+		{"NextWorkdayEnd", cal1.NextWorkdayEnd, dt(2020, 4, 4, 7, 0), dt(2020, 4, 7, 6, 0)},
+		{"NextWorkdayStart", cal3.NextWorkdayStart, dt(2020, 4, 7, 12, 0), dt(2020, 4, 9, 0, 0)},
+	}
+
+	for i, test := range timeTests {
+		got := test.fn(test.d)
+		if got != test.want {
+			t.Errorf("[%d] %s got: %s; want: %s", i, test.name, got, test.want)
+		}
+	}
+
+	rangeTests := []struct {
+		c    *BusinessCalendar
+		f    time.Time
+		t    time.Time
+		want time.Duration
+	}{
+		{cal1, dt(2020, 4, 1, 0, 0), dt(2020, 4, 2, 0, 0), 8 * time.Hour},
+		{cal1, dt(2020, 4, 2, 0, 0), dt(2020, 4, 3, 0, 0), 8 * time.Hour},
+		{cal1, dt(2020, 4, 2, 3, 0), dt(2020, 4, 2, 23, 0), 4 * time.Hour},
+		{cal1, dt(2020, 4, 3, 12, 0), dt(2020, 4, 7, 0, 0), 10 * time.Hour},
+		{cal1, dt(2020, 4, 6, 0, 0), dt(2020, 4, 13, 0, 0), 4 * 8 * time.Hour},
+		{cal3, dt(2020, 4, 4, 12, 0), dt(2020, 4, 6, 12, 0), 48 * time.Hour},
+		{cal3, dt(2020, 4, 7, 12, 0), dt(2020, 4, 9, 12, 0), 24 * time.Hour},
+	}
+
+	for i, test := range rangeTests {
+		got := test.c.WorkHoursInRange(test.f, test.t)
+		if got != test.want {
+			t.Errorf("[%d] WorkHoursInRange got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	addTests := []struct {
+		c    *BusinessCalendar
+		t    time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{cal1, dt(2020, 4, 1, 12, 0), 4 * time.Hour, dt(2020, 4, 2, 2, 0)},
+		{cal1, dt(2020, 4, 2, 3, 0), 4 * time.Hour, dt(2020, 4, 2, 23, 0)},
+		{cal1, dt(2020, 4, 4, 3, 0), 4 * time.Hour, dt(2020, 4, 6, 23, 0)},
+		{cal1, dt(2020, 4, 7, 12, 0), 8 * time.Hour, dt(2020, 4, 8, 6, 0)},
+		{cal1, dt(2020, 4, 7, 12, 0), 10 * time.Hour, dt(2020, 4, 10, 0, 0)},
+		{cal3, dt(2020, 4, 4, 12, 0), 30 * time.Hour, dt(2020, 4, 5, 18, 0)},
+		{cal3, dt(2020, 4, 7, 12, 0), 24 * time.Hour, dt(2020, 4, 9, 12, 0)},
+	}
+
+	for i, test := range addTests {
+		got := test.c.AddWorkHours(test.t, test.d)
+		if got != test.want {
+			t.Errorf("[%d] AddWorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	// an end function before the start continues into the next day
+	cal4 := NewBusinessCalendar()
+	cal4.SetWorkHours(22*time.Hour, 6*time.Hour)
+	cal4.WorkdayEndFunc = func(date time.Time) time.Time { return DayStart(date).Add(5 * time.Hour) }
+	if got := cal4.WorkHours(d(2020, 4, 1)); got != 7*time.Hour {
+		t.Errorf("WorkHours got: %s; want: 7h", got)
+	}
+	if got, want := cal4.WorkdayEnd(d(2020, 4, 1)), dt(2020, 4, 2, 5, 0); got != want {
+		t.Errorf("WorkdayEnd got: %s; want: %s", got, want)
+	}
+}
+
+func TestWorkSchedule(t *testing.T) {
+	h := func(start, end int) WorkInterval {
+		return WorkInterval{Start: time.Duration(start) * time.Hour, End: time.Duration(end) * time.Hour}
+	}
+
+	c := NewBusinessCalendar()
+	c.SetWorkday(time.Saturday, true)
+	c.Schedule = WorkSchedule{
+		time.Monday:    {h(9, 18)},
+		time.Tuesday:   {h(9, 18)},
+		time.Wednesday: {h(9, 18)},
+		time.Thursday:  {h(9, 18)},
+		time.Friday:    {h(9, 15)},
+	}
+	c.SetWeekdayWorkHours(time.Saturday, h(10, 14))
+	c.AddScheduleOverride(ScheduleOverride{
+		Start: d(2020, 6, 1),
+		End:   d(2020, 8, 31),
+		Schedule: WorkSchedule{
+			time.Monday:   {h(8, 12), h(13, 16)},
+			time.Friday:   {},
+			time.Saturday: {h(10, 12)},
+		},
+	}, ScheduleOverride{
+		Start:    d(2020, 8, 1),
+		End:      d(2020, 8, 31),
+		Schedule: WorkSchedule{time.Saturday: {}},
+	})
+
+	hourTests := []struct {
+		d    time.Time
+		want time.Duration
+	}{
+		{d(2020, 5, 4), 9 * time.Hour},  // Monday
+		{d(2020, 5, 7), 9 * time.Hour},  // Thursday
+		{d(2020, 5, 8), 6 * time.Hour},  // Friday
+		{d(2020, 5, 9), 4 * time.Hour},  // Saturday
+		{d(2020, 5, 10), 0},             // Sunday
+		{d(2020, 6, 1), 7 * time.Hour},  // summer Monday
+		{d(2020, 6, 2), 9 * time.Hour},  // summer Tuesday keeps its hours
+		{d(2020, 6, 5), 0},              // summer Friday
+		{d(2020, 6, 6), 2 * time.Hour},  // summer Saturday
+		{d(2020, 8, 8), 0},              // August Saturday
+		{d(2020, 8, 31), 7 * time.Hour}, // last day of summer
+		{d(2020, 9, 4), 6 * time.Hour},  // Friday after summer
+	}
+
+	for i, test := range hourTests {
+		got := c.WorkHours(test.d)
+		if got != test.want {
+			t.Errorf("[%d] WorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	workTimeTests := []struct {
+		d    time.Time
+		want bool
+	}{
+		{dt(2020, 5, 8, 14, 0), true},
+		{dt(2020, 5, 8, 16, 0), false},
+		{dt(2020, 5, 9, 9, 0), false},
+		{dt(2020, 5, 9, 11, 0), true},
+		{dt(2020, 6, 1, 12, 30), false},
+		{dt(2020, 6, 5, 10, 0), false},
+	}
+
+	for i, test := range workTimeTests {
+		got := c.IsWorkTime(test.d)
+		if got != test.want {
+			t.Errorf("[%d] IsWorkTime got: %t; want: %t", i, got, test.want)
+		}
+	}
+
+	if got, want := c.WorkHoursInRange(d(2020, 5, 4), d(2020, 5, 11)), 4*9*time.Hour+6*time.Hour+4*time.Hour; got != want {
+		t.Errorf("WorkHoursInRange got: %s; want: %s", got, want)
+	}
+	if got, want := c.WorkHoursInRange(d(2020, 6, 1), d(2020, 6, 8)), 7*time.Hour+3*9*time.Hour+2*time.Hour; got != want {
+		t.Errorf("WorkHoursInRange got: %s; want: %s", got, want)
+	}
+	if got, want := c.AddWorkHours(dt(2020, 6, 4, 17, 0), 3*time.Hour), dt(2020, 6, 6, 12, 0); got != want {
+		t.Errorf("AddWorkHours got: %s; want: %s", got, want)
+	}
+	if got, want := c.WorkdayEnd(d(2020, 5, 8)), dt(2020, 5, 8, 15, 0); got != want {
+		t.Errorf("WorkdayEnd got: %s; want: %s", got, want)
+	}
+}
+
+func TestSubWorkHours(t *testing.T) {
+	hol := &Holiday{Month: time.April, Day: 6, Func: CalcDayOfMonth}
+
+	cal1 := NewBusinessCalendar()
+	cal1.AddHoliday(hol)
+	cal2 := NewBusinessCalendar()
+	cal2.SetWorkIntervals(
+		WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour},
+		WorkInterval{Start: 13 * time.Hour, End: 17 * time.Hour},
+	)
+	cal3 := NewBusinessCalendar()
+	cal3.SetWorkHours(22*time.Hour, 6*time.Hour)
+
+	tests := []struct {
+		c    *BusinessCalendar
+		t    time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{cal1, dt(2020, 4, 1, 17, 0), 8 * time.Hour, dt(2020, 4, 1, 9, 0)},
+		{cal1, dt(2020, 4, 1, 23, 0), 8 * time.Hour, dt(2020, 4, 1, 9, 0)},
+		{cal1, dt(2020, 4, 2, 12, 0), 3 * time.Hour, dt(2020, 4, 2, 9, 0)},
+		{cal1, dt(2020, 4, 2, 8, 0), 3 * time.Hour, dt(2020, 4, 1, 14, 0)},
+		{cal1, dt(2020, 4, 1, 15, 20), 3*time.Hour + 20*time.Minute, dt(2020, 4, 1, 12, 0)},
+		{cal1, dt(2020, 4, 4, 9, 0), 0, dt(2020, 4, 4, 9, 0)},
+		{cal1, dt(2020, 4, 7, 10, 0), 2 * time.Hour, dt(2020, 4, 3, 16, 0)}, // over the weekend and holiday
+		{cal1, dt(2020, 4, 8, 17, 0), 24 * time.Hour, dt(2020, 4, 3, 9, 0)},
+
+		{cal2, dt(2020, 4, 1, 14, 0), 2 * time.Hour, dt(2020, 4, 1, 11, 0)},
+		{cal2, dt(2020, 4, 1, 12, 30), 1 * time.Hour, dt(2020, 4, 1, 11, 0)},
+		{cal2, dt(2020, 4, 2, 9, 0), 2 * time.Hour, dt(2020, 4, 1, 16, 0)},
+
+		{cal3, dt(2020, 4, 2, 2, 0), 3 * time.Hour, dt(2020, 4, 1, 23, 0)},
+		{cal3, dt(2020, 4, 6, 23, 0), 3 * time.Hour, dt(2020, 4, 4, 4, 0)},
+	}
+
+	for i, test := range tests {
+		got := test.c.SubWorkHours(test.t, test.d)
+		if got != test.want {
+			t.Errorf("[%d] SubWorkHours got: %s; want: %s", i, got, test.want)
+		}
+		if test.d == 0 {
+			continue
+		}
+		got = test.c.AddWorkHours(test.t, -test.d)
+		if got != test.want {
+			t.Errorf("[%d] AddWorkHours got: %s; want: %s", i, got, test.want)
+		}
+		// going forwards from the result finishes at the original time if
+		// it was work time
+		if test.c.IsWorkTime(test.t) {
+			got = test.c.AddWorkHours(test.want, test.d)
+			if got != test.t {
+				t.Errorf("[%d] AddWorkHours got: %s; want: %s", i, got, test.t)
+			}
+		}
+	}
+
+	if got, want := cal1.SubWorkHours(dt(2020, 4, 1, 12, 0), -2*time.Hour), dt(2020, 4, 1, 14, 0); got != want {
+		t.Errorf("SubWorkHours got: %s; want: %s", got, want)
+	}
+	empty := NewBusinessCalendar()
+	empty.SetWorkIntervals()
+	if got := empty.SubWorkHours(dt(2020, 4, 1, 8, 0), time.Hour); !got.IsZero() {
+		t.Errorf("SubWorkHours got: %s; want zero time", got)
+	}
+}
+
+func TestPrevWorkday(t *testing.T) {
+	hol := &Holiday{Month: time.April, Day: 6, Func: CalcDayOfMonth}
+
+	cal1 := NewBusinessCalendar()
+	cal1.AddHoliday(hol)
+	cal2 := NewBusinessCalendar()
+	cal2.SetWorkHours(22*time.Hour, 6*time.Hour)
+	cal3 := NewBusinessCalendar()
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		cal3.SetWorkday(d, false)
+	}
+
+	tests := []struct {
+		name string
+		fn   func(time.Time) time.Time
+		d    time.Time
+		want time.Time
+	}{
+		{"PrevWorkdayStart", cal1.PrevWorkdayStart, dt(2020, 4, 1, 12, 0), dt(2020, 4, 1, 9, 0)},
+		{"PrevWorkdayStart", cal1.PrevWorkdayStart, dt(2020, 4, 1, 9, 0), dt(2020, 4, 1, 9, 0)},
+		{"PrevWorkdayStart", cal1.PrevWorkdayStart, dt(2020, 4, 1, 8, 0), dt(2020, 3, 31, 9, 0)},
+		{"PrevWorkdayStart", cal1.PrevWorkdayStart, dt(2020, 4, 7, 8, 0), dt(2020, 4, 3, 9, 0)},
+		{"PrevWorkdayEnd", cal1.PrevWorkdayEnd, dt(2020, 4, 1, 18, 0), dt(2020, 4, 1, 17, 0)},
+		{"PrevWorkdayEnd", cal1.PrevWorkdayEnd, dt(2020, 4, 1, 17, 0), dt(2020, 4, 1, 17, 0)},
+		{"PrevWorkdayEnd", cal1.PrevWorkdayEnd, dt(2020, 4, 1, 12, 0), dt(2020, 3, 31, 17, 0)},
+		{"PrevWorkdayEnd", cal1.PrevWorkdayEnd, dt(2020, 4, 7, 12, 0), dt(2020, 4, 3, 17, 0)},
+
+		{"PrevWorkdayStart", cal2.PrevWorkdayStart, dt(2020, 4, 4, 3, 0), dt(2020, 4, 3, 22, 0)},
+		{"PrevWorkdayStart", cal2.PrevWorkdayStart, dt(2020, 4, 6, 12, 0), dt(2020, 4, 3, 22, 0)},
+		{"PrevWorkdayEnd", cal2.PrevWorkdayEnd, dt(2020, 4, 4, 3, 0), dt(2020, 4, 3, 6, 0)},
+		{"PrevWorkdayEnd", cal2.PrevWorkdayEnd, dt(2020, 4, 6, 12, 0), dt(2020, 4, 4, 6, 0)},
+		{"PrevWorkdayStart", cal3.PrevWorkdayStart, dt(2020, 4, 6, 12, 0), time.Time{}},
+		{"PrevWorkdayEnd", cal3.PrevWorkdayEnd, dt(2020, 4, 6, 12, 0), time.Time{}},
+	}
+
+	for i, test := range tests {
+		got := test.fn(test.d)
+		if got != test.want {
+			t.Errorf("[%d] %s got: %s; want: %s", i, test.name, got, test.want)
+		}
+	}
+}
+
+func TestWorkPeriods(t *testing.T) {
+	hol := &Holiday{Month: time.April, Day: 6, Func: CalcDayOfMonth}
+
+	cal1 := NewBusinessCalendar()
+	cal1.AddHoliday(hol)
+	cal1.SetWorkIntervals(
+		WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour},
+		WorkInterval{Start: 13 * time.Hour, End: 17 * time.Hour},
+	)
+	cal2 := NewBusinessCalendar()
+	cal2.WorkdayFunc = func(date time.Time) bool {
+		return date.Day()%2 == 0
+	}
+	cal2.WorkdayStartFunc = func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), date.Day()%12, 30, 0, 0, time.UTC)
+	}
+	cal2.WorkdayEndFunc = func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), date.Day()%12+6, 45, 0, 0, time.UTC)
+	}
+	cal3 := New24x7BusinessCalendar()
+	cal3.AddHoliday(hol)
+
+	p := func(f, t time.Time) Period {
+		return Period{Start: f, End: t}
+	}
+
+	tests := []struct {
+		c        *BusinessCalendar
+		f        time.Time
+		t        time.Time
+		wantWork []Period
+		wantOff  []Period
+	}{
+		{cal1, dt(2020, 4, 3, 10, 0), dt(2020, 4, 7, 9, 0),
+			[]Period{
+				p(dt(2020, 4, 3, 10, 0), dt(2020, 4, 3, 12, 0)),
+				p(dt(2020, 4, 3, 13, 0), dt(2020, 4, 3, 17, 0)),
+				p(dt(2020, 4, 7, 8, 0), dt(2020, 4, 7, 9, 0)),
+			},
+			[]Period{
+				p(dt(2020, 4, 3, 12, 0), dt(2020, 4, 3, 13, 0)),
+				p(dt(2020, 4, 3, 17, 0), dt(2020, 4, 7, 8, 0)),
+			}},
+		{cal1, dt(2020, 4, 4, 10, 0), dt(2020, 4, 5, 10, 0),
+			nil,
+			[]Period{p(dt(2020, 4, 4, 10, 0), dt(2020, 4, 5, 10, 0))}},
+		{cal2, dt(2020, 4, 1, 0, 0), dt(2020, 4, 4, 0, 0),
+			[]Period{p(dt(2020, 4, 2, 2, 30), dt(2020, 4, 2, 8, 45))},
+			[]Period{
+				p(dt(2020, 4, 1, 0, 0), dt(2020, 4, 2, 2, 30)),
+				p(dt(2020, 4, 2, 8, 45), dt(2020, 4, 4, 0, 0)),
+			}},
+		{cal3, dt(2020, 4, 4, 12, 0), dt(2020, 4, 9, 12, 0),
+			[]Period{
+				p(dt(2020, 4, 4, 12, 0), dt(2020, 4, 6, 0, 0)),
+				p(dt(2020, 4, 7, 0, 0), dt(2020, 4, 9, 12, 0)),
+			},
+			[]Period{p(dt(2020, 4, 6, 0, 0), dt(2020, 4, 7, 0, 0))}},
+	}
+
+	for i, test := range tests {
+		for _, flip := range []bool{false, true} {
+			f, to := test.f, test.t
+			if flip {
+				f, to = to, f
+			}
+			if got := test.c.WorkPeriods(f, to); !reflect.DeepEqual(got, test.wantWork) {
+				t.Errorf("[%d] WorkPeriods got: %v; want: %v", i, got, test.wantWork)
+			}
+			if got := test.c.OffPeriods(f, to); !reflect.DeepEqual(got, test.wantOff) {
+				t.Errorf("[%d] OffPeriods got: %v; want: %v", i, got, test.wantOff)
+			}
+		}
+	}
+}
+
+func TestHolidayPolicy(t *testing.T) {
+	other := &Holiday{Type: ObservanceOther, Month: time.May, Day: 4, Func: CalcDayOfMonth}
+	bank := &Holiday{Type: ObservanceBank, Month: time.May, Day: 4, Func: CalcDayOfMonth}
+	rel := &Holiday{Type: ObservanceReligious, Month: time.April, Day: 14,
+		Observed: []AltDay{{Day: time.Tuesday, Offset: -1}}, Func: CalcDayOfMonth}
+	pub := &Holiday{Type: ObservancePublic, Month: time.July, Day: 4,
+		Observed: []AltDay{{Day: time.Saturday, Offset: -1}}, Func: CalcDayOfMonth}
+
+	cal := func(p HolidayPolicy) *BusinessCalendar {
+		c := NewBusinessCalendar()
+		c.AddHoliday(other, bank, rel, pub)
+		c.Policy = p
+		return c
+	}
+	all := cal(HolidayPolicy{})
+	branch := cal(HolidayPolicy{Types: []ObservanceType{ObservancePublic, ObservanceBank}})
+	retail := cal(HolidayPolicy{Types: []ObservanceType{ObservancePublic}})
+	actual := cal(HolidayPolicy{Actual: true})
+	elsewhere := cal(HolidayPolicy{Actual: true})
+	elsewhere.Locations = []*time.Location{time.FixedZone("test", 3600)}
+
+	tests := []struct {
+		c        *BusinessCalendar
+		workdays []bool // 13-Apr, 14-Apr, 4-May, 3-Jul
+		holidays int
+	}{
+		{all, []bool{false, true, false, false}, 3},
+		{branch, []bool{true, true, false, false}, 2},
+		{retail, []bool{true, true, true, false}, 1},
+		{actual, []bool{true, false, false, true}, 3}, // 4-Jul is a Saturday
+		{elsewhere, []bool{true, true, true, true}, 0},
+	}
+
+	days := []time.Time{d(2020, 4, 13), d(2020, 4, 14), d(2020, 5, 4), d(2020, 7, 3)}
+	for i, test := range tests {
+		for j, day := range days {
+			if got := test.c.IsWorkday(day); got != test.workdays[j] {
+				t.Errorf("[%d] IsWorkday(%s) got: %t; want: %t", i, day, got, test.workdays[j])
+			}
+		}
+		if got := test.c.HolidaysInRange(d(2020, 4, 1), d(2020, 7, 31)); got != test.holidays {
+			t.Errorf("[%d] HolidaysInRange got: %d; want: %d", i, got, test.holidays)
+		}
+		open := 88
+		for _, w := range test.workdays {
+			if !w {
+				open--
+			}
+		}
+		if got := test.c.WorkdaysInRange(d(2020, 4, 1), d(2020, 7, 31)); got != open {
+			t.Errorf("[%d] WorkdaysInRange got: %d; want: %d", i, got, open)
+		}
+	}
+
+	// the policy applies to holidays of composite members too
+	joint := NewCompositeCalendar(JointOpen, retail)
+	joint.Policy = HolidayPolicy{Types: []ObservanceType{ObservanceReligious}, Actual: true}
+	if joint.IsWorkday(d(2020, 4, 14)) || !joint.IsWorkday(d(2020, 4, 15)) || !joint.IsWorkday(d(2020, 5, 4)) {
+		t.Errorf("composite policy not applied")
+	}
+}
@@ -0,0 +1,125 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// CompositeMode determines how the member calendars of a CompositeCalendar
+// are combined.
+type CompositeMode uint8
+
+// Allowed values for CompositeMode
+const (
+	JointOpen CompositeMode = iota // open only when every member is open; holidays of any member apply
+	AnyOpen                        // open when any member is open; only holidays shared by every member apply
+)
+
+// CompositeCalendar combines several business calendars into one, such as
+// the calendars of two countries for cross-border payments (JointOpen) or
+// the calendars of several offices of a global team (AnyOpen).
+//
+// The composite has the same query methods as a BusinessCalendar. Workdays,
+// holidays and working hours are all derived from the member calendars, so
+// the composite's own workday and working hour settings are not used.
+// Holidays added to the composite itself apply in addition to the members'
+// holidays.
+type CompositeCalendar struct {
+	Mode    CompositeMode  // how the members are combined
+	Members []WorkCalendar // the calendars being combined
+
+	BusinessCalendar
+}
+
+// NewCompositeCalendar creates a new CompositeCalendar that combines the
+// given calendars.
+func NewCompositeCalendar(mode CompositeMode, members ...WorkCalendar) *CompositeCalendar {
+	c := &CompositeCalendar{
+		Mode:    mode,
+		Members: members,
+	}
+	c.WorkdayFunc = c.memberWorkday
+	c.holidayFunc = c.memberHoliday
+	c.periodsFunc = c.memberPeriods
+	c.composite = c
+	return c
+}
+
+// HolidayMember reports whether a given date is a holiday or an observation
+// day and the member calendar that contributed the holiday.
+//
+// In JointOpen mode, the holiday of the first member that has one is
+// reported. In AnyOpen mode, the date is only a holiday if every member has a
+// holiday on it, and the first member's holiday is reported.
+//
+// If the holiday was added to the composite itself, the member is nil.
+func (c *CompositeCalendar) HolidayMember(date time.Time) (actual, observed bool, h *Holiday, member WorkCalendar) {
+	if actual, observed, h = c.isHoliday(date); h != nil {
+		return actual, observed, h, nil
+	}
+
+	if c.Mode == AnyOpen {
+		for i, m := range c.Members {
+			act, obs, hol := m.IsHoliday(date)
+			if hol == nil {
+				return false, false, nil, nil
+			}
+			if i == 0 {
+				actual, observed, h, member = act, obs, hol, m
+			}
+		}
+		return actual, observed, h, member
+	}
+
+	for _, m := range c.Members {
+		if act, obs, hol := m.IsHoliday(date); hol != nil {
+			return act, obs, hol, m
+		}
+	}
+	return false, false, nil, nil
+}
+
+// memberHoliday reports the holidays of the member calendars.
+func (c *CompositeCalendar) memberHoliday(date time.Time) (actual, observed bool, h *Holiday) {
+	actual, observed, h, _ = c.HolidayMember(date)
+	return actual, observed, h
+}
+
+// memberWorkday reports whether the date is a workday for the member
+// calendars.
+func (c *CompositeCalendar) memberWorkday(date time.Time) bool {
+	if len(c.Members) == 0 {
+		return false
+	}
+	for _, m := range c.Members {
+		open := m.IsWorkday(date)
+		if c.Mode == AnyOpen && open {
+			return true
+		} else if c.Mode == JointOpen && !open {
+			return false
+		}
+	}
+	return c.Mode == JointOpen
+}
+
+// memberPeriods reports the combined working time of the member calendars in
+// the given day.
+func (c *CompositeCalendar) memberPeriods(day time.Time) []Period {
+	start := DayStart(day)
+	end := DayStart(addDays(day, 1))
+	var r []Period
+	for i, m := range c.Members {
+		periods := m.WorkPeriods(start, end)
+		for j := range periods {
+			periods[j] = Period{periods[j].Start.In(day.Location()), periods[j].End.In(day.Location())}
+		}
+		switch {
+		case i == 0:
+			r = periods
+		case c.Mode == AnyOpen:
+			r = mergePeriods(append(r, periods...))
+		default:
+			r = intersectPeriods(r, periods)
+		}
+	}
+	return r
+}
@@ -0,0 +1,158 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCompositeCalendar(t *testing.T) {
+	hol1 := &Holiday{Name: "hol1", Month: time.April, Day: 10, Func: CalcDayOfMonth}
+	hol2 := &Holiday{Name: "hol2", Month: time.April, Day: 13, Func: CalcDayOfMonth}
+	xmas1 := &Holiday{Name: "xmas1", Month: time.December, Day: 25, Func: CalcDayOfMonth}
+	xmas2 := &Holiday{Name: "xmas2", Month: time.December, Day: 25, Func: CalcDayOfMonth}
+	extra := &Holiday{Name: "extra", Month: time.April, Day: 15, Func: CalcDayOfMonth}
+
+	c1 := NewBusinessCalendar()
+	c1.AddHoliday(hol1, xmas1)
+	c2 := NewBusinessCalendar()
+	c2.AddHoliday(hol2, xmas2)
+	c2.SetWorkday(time.Saturday, true)
+	c2.SetWorkIntervals(
+		WorkInterval{Start: 8 * time.Hour, End: 12 * time.Hour},
+		WorkInterval{Start: 13 * time.Hour, End: 16 * time.Hour},
+	)
+
+	joint := NewCompositeCalendar(JointOpen, c1, c2)
+	anyOpen := NewCompositeCalendar(AnyOpen, c1, c2)
+	own := NewCompositeCalendar(AnyOpen, c1, c2)
+	own.AddHoliday(extra)
+
+	holTests := []struct {
+		c          *CompositeCalendar
+		d          time.Time
+		wantAct    bool
+		wantObs    bool
+		wantHol    *Holiday
+		wantMember WorkCalendar
+	}{
+		{joint, d(2020, 4, 10), true, true, hol1, c1},
+		{joint, d(2020, 4, 13), true, true, hol2, c2},
+		{joint, d(2020, 4, 14), false, false, nil, nil},
+		{joint, d(2020, 12, 25), true, true, xmas1, c1},
+		{anyOpen, d(2020, 4, 10), false, false, nil, nil},
+		{anyOpen, d(2020, 4, 13), false, false, nil, nil},
+		{anyOpen, d(2020, 12, 25), true, true, xmas1, c1},
+		{own, d(2020, 4, 15), true, true, extra, nil},
+		{own, d(2020, 12, 25), true, true, xmas1, c1},
+	}
+
+	for i, test := range holTests {
+		act, obs, h, m := test.c.HolidayMember(test.d)
+		if act != test.wantAct || obs != test.wantObs || h != test.wantHol || m != test.wantMember {
+			t.Errorf("[%d] HolidayMember got: %t, %t, %v, %v; want: %t, %t, %v, %v", i,
+				act, obs, h, m, test.wantAct, test.wantObs, test.wantHol, test.wantMember)
+		}
+		act, obs, h = test.c.IsHoliday(test.d)
+		if act != test.wantAct || obs != test.wantObs || h != test.wantHol {
+			t.Errorf("[%d] IsHoliday got: %t, %t, %v; want: %t, %t, %v", i,
+				act, obs, h, test.wantAct, test.wantObs, test.wantHol)
+		}
+	}
+
+	workdayTests := []struct {
+		c    *CompositeCalendar
+		d    time.Time
+		want bool
+	}{
+		{joint, d(2020, 4, 9), true},
+		{joint, d(2020, 4, 10), false},
+		{joint, d(2020, 4, 11), false},
+		{joint, d(2020, 4, 13), false},
+		{anyOpen, d(2020, 4, 10), true},
+		{anyOpen, d(2020, 4, 11), true},
+		{anyOpen, d(2020, 4, 12), false},
+		{anyOpen, d(2020, 12, 25), false},
+		{own, d(2020, 4, 15), false},
+	}
+
+	for i, test := range workdayTests {
+		if got := test.c.IsWorkday(test.d); got != test.want {
+			t.Errorf("[%d] IsWorkday got: %t; want: %t", i, got, test.want)
+		}
+	}
+
+	hourTests := []struct {
+		c    *CompositeCalendar
+		d    time.Time
+		want time.Duration
+	}{
+		{joint, d(2020, 4, 14), 6 * time.Hour},
+		{joint, d(2020, 4, 10), 0},
+		{anyOpen, d(2020, 4, 14), 9 * time.Hour},
+		{anyOpen, d(2020, 4, 10), 7 * time.Hour},
+		{anyOpen, d(2020, 4, 13), 8 * time.Hour},
+		{anyOpen, d(2020, 4, 11), 7 * time.Hour},
+	}
+
+	for i, test := range hourTests {
+		if got := test.c.WorkHours(test.d); got != test.want {
+			t.Errorf("[%d] WorkHours got: %s; want: %s", i, got, test.want)
+		}
+	}
+
+	if got := joint.WorkdaysInRange(d(2020, 4, 6), d(2020, 4, 17)); got != 8 {
+		t.Errorf("WorkdaysInRange got: %d; want: 8", got)
+	}
+	if got := anyOpen.WorkdaysInRange(d(2020, 4, 6), d(2020, 4, 17)); got != 11 {
+		t.Errorf("WorkdaysInRange got: %d; want: 11", got)
+	}
+	if got := joint.HolidaysInRange(d(2020, 4, 6), d(2020, 4, 17)); got != 2 {
+		t.Errorf("HolidaysInRange got: %d; want: 2", got)
+	}
+	if got := anyOpen.HolidaysInRange(d(2020, 4, 6), d(2020, 4, 17)); got != 0 {
+		t.Errorf("HolidaysInRange got: %d; want: 0", got)
+	}
+	if got, want := joint.WorkdaysFrom(d(2020, 4, 9), 1), d(2020, 4, 14); got != want {
+		t.Errorf("WorkdaysFrom got: %s; want: %s", got, want)
+	}
+	if got, want := joint.AddWorkHours(dt(2020, 4, 9, 15, 0), 3*time.Hour), dt(2020, 4, 14, 11, 0); got != want {
+		t.Errorf("AddWorkHours got: %s; want: %s", got, want)
+	}
+	if got, want := anyOpen.WorkHoursInRange(dt(2020, 4, 10, 0, 0), dt(2020, 4, 14, 0, 0)), 7*time.Hour+7*time.Hour+8*time.Hour; got != want {
+		t.Errorf("WorkHoursInRange got: %s; want: %s", got, want)
+	}
+	if !joint.IsWorkTime(dt(2020, 4, 14, 15, 0)) || joint.IsWorkTime(dt(2020, 4, 14, 12, 30)) ||
+		!anyOpen.IsWorkTime(dt(2020, 4, 14, 12, 30)) || joint.IsWorkTime(dt(2020, 4, 14, 16, 30)) {
+		t.Errorf("unexpected IsWorkTime result")
+	}
+
+	empty := NewCompositeCalendar(JointOpen)
+	if empty.IsWorkday(d(2020, 4, 14)) || empty.WorkHours(d(2020, 4, 14)) != 0 {
+		t.Errorf("expected no workdays for composite without members")
+	}
+}
+
+func TestCompositeCalendarLocations(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	c1 := NewBusinessCalendar()
+	c1.Location = ny
+	c2 := NewBusinessCalendar()
+	c2.Location = berlin
+
+	// 9-17 in New York and 9-17 in Berlin overlap from 15:00-17:00 in Berlin
+	joint := NewCompositeCalendar(JointOpen, c1, c2)
+	if got := joint.WorkHours(time.Date(2020, 4, 14, 12, 0, 0, 0, berlin)); got != 2*time.Hour {
+		t.Errorf("WorkHours got: %s; want: 2h", got)
+	}
+	if got, want := joint.WorkdayStart(time.Date(2020, 4, 14, 12, 0, 0, 0, berlin)),
+		time.Date(2020, 4, 14, 15, 0, 0, 0, berlin); !got.Equal(want) {
+		t.Errorf("WorkdayStart got: %s; want: %s", got, want)
+	}
+}
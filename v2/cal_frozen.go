@@ -0,0 +1,327 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// BusinessCalendarBuilder builds FrozenCalendars. The builder's methods can be
+// chained:
+//
+//	c := cal.NewBusinessCalendarBuilder().
+//		AddHoliday(us.Holidays...).
+//		SetWorkHours(8*time.Hour, 16*time.Hour).
+//		Build()
+//
+// A builder is not safe for concurrent use, but the calendars it builds are.
+// Changing the builder after calling Build doesn't affect calendars that have
+// already been built.
+type BusinessCalendarBuilder struct {
+	c *BusinessCalendar
+}
+
+// NewBusinessCalendarBuilder creates a new builder for a calendar with no
+// holidays defined and work days of Monday through Friday from 9am-5pm.
+func NewBusinessCalendarBuilder() *BusinessCalendarBuilder {
+	return &BusinessCalendarBuilder{c: NewBusinessCalendar()}
+}
+
+// SetName sets the calendar's short name.
+func (b *BusinessCalendarBuilder) SetName(name string) *BusinessCalendarBuilder {
+	b.c.Name = name
+	return b
+}
+
+// SetDescription sets the calendar's description.
+func (b *BusinessCalendarBuilder) SetDescription(desc string) *BusinessCalendarBuilder {
+	b.c.Description = desc
+	return b
+}
+
+// SetLocation sets the location of holiday dates and business hours. See
+// Calendar.Location.
+func (b *BusinessCalendarBuilder) SetLocation(loc *time.Location) *BusinessCalendarBuilder {
+	b.c.Location = loc
+	return b
+}
+
+// AddLocation adds locations where the calendar applies. See
+// Calendar.Locations.
+func (b *BusinessCalendarBuilder) AddLocation(loc ...*time.Location) *BusinessCalendarBuilder {
+	b.c.Locations = append(b.c.Locations, loc...)
+	return b
+}
+
+// SetLocationMatch sets how locations are compared to the calendar's
+// locations.
+func (b *BusinessCalendarBuilder) SetLocationMatch(m LocationMatch) *BusinessCalendarBuilder {
+	b.c.LocationMatch = m
+	return b
+}
+
+// AddHoliday adds holidays to the calendar. The built calendar uses copies of
+// the holidays, so they can be changed later without affecting it.
+func (b *BusinessCalendarBuilder) AddHoliday(h ...*Holiday) *BusinessCalendarBuilder {
+	b.c.AddHoliday(h...)
+	return b
+}
+
+// SetWorkday changes the given day's status as a standard working day.
+func (b *BusinessCalendarBuilder) SetWorkday(day time.Weekday, workday bool) *BusinessCalendarBuilder {
+	b.c.SetWorkday(day, workday)
+	return b
+}
+
+// SetWorkdayFunc sets a function to override the workday flags. The function
+// must be safe for concurrent use.
+func (b *BusinessCalendarBuilder) SetWorkdayFunc(fn WorkdayFn) *BusinessCalendarBuilder {
+	b.c.WorkdayFunc = fn
+	return b
+}
+
+// SetWorkHours sets the start and end times for a workday. See
+// BusinessCalendar.SetWorkHours.
+func (b *BusinessCalendarBuilder) SetWorkHours(start, end time.Duration) *BusinessCalendarBuilder {
+	b.c.SetWorkHours(start, end)
+	return b
+}
+
+// SetWorkIntervals sets the periods of work in a workday. See
+// BusinessCalendar.SetWorkIntervals.
+func (b *BusinessCalendarBuilder) SetWorkIntervals(intervals ...WorkInterval) *BusinessCalendarBuilder {
+	b.c.SetWorkIntervals(intervals...)
+	return b
+}
+
+// SetWeekdayWorkHours sets the work intervals for the given day of the week.
+// See BusinessCalendar.SetWeekdayWorkHours.
+func (b *BusinessCalendarBuilder) SetWeekdayWorkHours(day time.Weekday, intervals ...WorkInterval) *BusinessCalendarBuilder {
+	b.c.SetWeekdayWorkHours(day, intervals...)
+	return b
+}
+
+// AddScheduleOverride adds work schedules for date ranges. See
+// BusinessCalendar.AddScheduleOverride.
+func (b *BusinessCalendarBuilder) AddScheduleOverride(o ...ScheduleOverride) *BusinessCalendarBuilder {
+	b.c.AddScheduleOverride(o...)
+	return b
+}
+
+// SetHolidayPolicy sets which holidays are days off. See HolidayPolicy.
+func (b *BusinessCalendarBuilder) SetHolidayPolicy(p HolidayPolicy) *BusinessCalendarBuilder {
+	b.c.Policy = p
+	return b
+}
+
+// SetWorkIntervalsFunc sets a function to override the work intervals. The
+// function must be safe for concurrent use.
+func (b *BusinessCalendarBuilder) SetWorkIntervalsFunc(fn WorkIntervalsFn) *BusinessCalendarBuilder {
+	b.c.WorkIntervalsFunc = fn
+	return b
+}
+
+// Build creates a FrozenCalendar from the builder's settings.
+func (b *BusinessCalendarBuilder) Build() *FrozenCalendar {
+	return Freeze(b.c)
+}
+
+// FrozenCalendar is a read-only business calendar that is safe for concurrent
+// use by multiple goroutines. Create one with a BusinessCalendarBuilder or by
+// freezing an existing BusinessCalendar.
+//
+// The holidays reported by a FrozenCalendar are shared by all of its users
+// and must not be modified.
+type FrozenCalendar struct {
+	workCalendar
+
+	c *BusinessCalendar
+}
+
+// workCalendar is embedded in FrozenCalendar to provide the WorkCalendar
+// methods without exposing the underlying calendar.
+type workCalendar interface {
+	WorkCalendar
+}
+
+// Freeze creates a FrozenCalendar with a copy of the given calendar's
+// settings and holidays. Later changes to the given calendar don't affect the
+// frozen calendar.
+//
+// Functions set on the calendar, such as WorkdayFunc, are used as they are
+// and must be safe for concurrent use.
+//
+// If the calendar is the BusinessCalendar of a CompositeCalendar, the members
+// are frozen too: BusinessCalendar and CompositeCalendar members are frozen
+// with Freeze, and other members, such as FrozenCalendars, are used as they
+// are.
+func Freeze(c *BusinessCalendar) *FrozenCalendar {
+	if c.composite != nil {
+		return c.composite.freeze()
+	}
+	f := &BusinessCalendar{}
+	c.copyTo(f)
+	return &FrozenCalendar{workCalendar: f, c: f}
+}
+
+// freeze creates a FrozenCalendar from a copy of the composite whose members
+// are frozen and whose member functions are bound to the copy.
+func (c *CompositeCalendar) freeze() *FrozenCalendar {
+	members := make([]WorkCalendar, len(c.Members))
+	for i, m := range c.Members {
+		switch m := m.(type) {
+		case *BusinessCalendar:
+			members[i] = Freeze(m)
+		case *CompositeCalendar:
+			members[i] = Freeze(&m.BusinessCalendar)
+		default:
+			members[i] = m
+		}
+	}
+
+	f := NewCompositeCalendar(c.Mode, members...)
+	c.BusinessCalendar.copyTo(&f.BusinessCalendar)
+	f.WorkdayFunc = f.memberWorkday
+	f.holidayFunc = f.memberHoliday
+	f.periodsFunc = f.memberPeriods
+	return &FrozenCalendar{workCalendar: f, c: &f.BusinessCalendar}
+}
+
+// copyTo copies the calendar's settings and holidays to f, sharing no memory
+// other than the calendar's functions.
+func (c *BusinessCalendar) copyTo(f *BusinessCalendar) {
+	f.workday = c.workday
+	f.WorkdayFunc = c.WorkdayFunc
+	f.workdayStart = c.workdayStart
+	f.WorkdayStartFunc = c.WorkdayStartFunc
+	f.workdayEnd = c.workdayEnd
+	f.WorkdayEndFunc = c.WorkdayEndFunc
+	f.Schedule = copySchedule(c.Schedule)
+	f.WorkIntervalsFunc = c.WorkIntervalsFunc
+	f.Policy = c.Policy
+	f.periodsFunc = c.periodsFunc
+	f.Policy.Types = append([]ObservanceType(nil), c.Policy.Types...)
+	if c.workIntervals != nil {
+		f.workIntervals = append([]WorkInterval{}, c.workIntervals...)
+	}
+	for _, o := range c.ScheduleOverrides {
+		o.Schedule = copySchedule(o.Schedule)
+		f.ScheduleOverrides = append(f.ScheduleOverrides, o)
+	}
+
+	f.Name = c.Name
+	f.Description = c.Description
+	f.Location = c.Location
+	f.LocationMatch = c.LocationMatch
+	f.holidayFunc = c.holidayFunc
+	f.Cacheable = true
+	for _, h := range c.Holidays {
+		h = h.Clone(nil)
+		h.Except = append([]int(nil), h.Except...)
+		h.Observed = append([]AltDay(nil), h.Observed...)
+		h.Subdivisions = append([]string(nil), h.Subdivisions...)
+		f.Holidays = append(f.Holidays, h)
+	}
+	if c.Locations != nil {
+		f.Locations = append([]*time.Location{}, c.Locations...)
+	}
+}
+
+// copySchedule reports a copy of the given schedule that shares no memory
+// with it. Nil entries are kept nil.
+func copySchedule(s WorkSchedule) WorkSchedule {
+	for i, iv := range s {
+		if iv != nil {
+			s[i] = append([]WorkInterval{}, iv...)
+		}
+	}
+	return s
+}
+
+// Name reports the calendar's short name.
+func (f *FrozenCalendar) Name() string {
+	return f.c.Name
+}
+
+// Description reports the calendar's description.
+func (f *FrozenCalendar) Description() string {
+	return f.c.Description
+}
+
+// Holidays reports the calendar's holidays. The returned slice is a copy, but
+// the holidays must not be modified.
+func (f *FrozenCalendar) Holidays() []*Holiday {
+	return append([]*Holiday(nil), f.c.Holidays...)
+}
+
+// IsApplicable reports whether the calendar is applicable for the given
+// location. See Calendar.IsApplicable.
+func (f *FrozenCalendar) IsApplicable(loc *time.Location) bool {
+	return f.c.IsApplicable(loc)
+}
+
+// AtomicCalendar holds a FrozenCalendar that can be replaced while it is in
+// use, such as when a long-running service reloads its holidays. It is safe
+// for concurrent use by multiple goroutines.
+//
+// Load the calendar once for each unit of work, such as a request, so that
+// all of its queries use the same calendar:
+//
+//	c := holidays.Load()
+//	due := c.WorkdaysFrom(start, 5)
+type AtomicCalendar struct {
+	v  atomic.Value // holds the current *FrozenCalendar
+	mu sync.Mutex   // serializes changes to the current calendar
+}
+
+// NewAtomicCalendar creates a new AtomicCalendar holding the given calendar.
+func NewAtomicCalendar(c *FrozenCalendar) *AtomicCalendar {
+	a := &AtomicCalendar{}
+	a.Store(c)
+	return a
+}
+
+// Load reports the current calendar.
+func (a *AtomicCalendar) Load() *FrozenCalendar {
+	c, _ := a.v.Load().(*FrozenCalendar)
+	return c
+}
+
+// Store replaces the current calendar. A nil calendar is ignored.
+func (a *AtomicCalendar) Store(c *FrozenCalendar) {
+	a.Swap(c)
+}
+
+// Swap replaces the current calendar and reports the calendar it replaced. A
+// nil calendar is ignored.
+func (a *AtomicCalendar) Swap(c *FrozenCalendar) (old *FrozenCalendar) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	old = a.Load()
+	a.store(c)
+	return old
+}
+
+// Reload replaces the current calendar with the one returned by build. If
+// build returns an error, the current calendar is kept and the error is
+// returned. Concurrent reloads are run one at a time.
+func (a *AtomicCalendar) Reload(build func() (*FrozenCalendar, error)) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	c, err := build()
+	if err != nil {
+		return err
+	}
+	a.store(c)
+	return nil
+}
+
+// store replaces the current calendar unless c is nil. The caller must hold
+// a.mu.
+func (a *AtomicCalendar) store(c *FrozenCalendar) {
+	if c != nil {
+		a.v.Store(c)
+	}
+}
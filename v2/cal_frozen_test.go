@@ -0,0 +1,226 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendarBuilder(t *testing.T) {
+	hol := &Holiday{Name: "Test", Month: time.April, Day: 14, Func: CalcDayOfMonth}
+	b := NewBusinessCalendarBuilder().
+		SetName("test").
+		SetDescription("test calendar").
+		AddHoliday(hol).
+		SetWorkday(time.Saturday, true).
+		SetWorkIntervals(WorkInterval{8 * time.Hour, 12 * time.Hour}, WorkInterval{13 * time.Hour, 17 * time.Hour}).
+		SetWeekdayWorkHours(time.Saturday, WorkInterval{10 * time.Hour, 14 * time.Hour})
+	c := b.Build()
+
+	// changes after Build don't affect the built calendar
+	hol.Day = 15
+	b.SetWorkday(time.Saturday, false).SetWorkHours(0, 24*time.Hour).AddHoliday(&Holiday{Month: time.April, Day: 16, Func: CalcDayOfMonth})
+
+	if c.Name() != "test" || c.Description() != "test calendar" {
+		t.Errorf("got: %q, %q; want: test, test calendar", c.Name(), c.Description())
+	}
+	if len(c.Holidays()) != 1 {
+		t.Errorf("got: %d holidays; want: 1", len(c.Holidays()))
+	}
+	if act, _, h := c.IsHoliday(d(2020, 4, 14)); !act || h == hol || h.Name != "Test" {
+		t.Errorf("got: %t, %v; want copy of holiday", act, h)
+	}
+	if act, _, _ := c.IsHoliday(d(2020, 4, 15)); act {
+		t.Errorf("holiday changed after build")
+	}
+	if c.IsWorkday(d(2020, 4, 14)) || !c.IsWorkday(d(2020, 4, 16)) || !c.IsWorkday(d(2020, 4, 18)) {
+		t.Errorf("unexpected workdays")
+	}
+	if got := c.WorkHours(d(2020, 4, 16)); got != 8*time.Hour {
+		t.Errorf("got: %s; want: 8h", got)
+	}
+	if got := c.WorkHours(d(2020, 4, 18)); got != 4*time.Hour {
+		t.Errorf("got: %s; want: 4h", got)
+	}
+	if got, want := c.AddWorkHours(dt(2020, 4, 13, 16, 0), 2*time.Hour), dt(2020, 4, 15, 9, 0); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+
+	frozen := b.Build()
+	if frozen.IsWorkday(d(2020, 4, 18)) || frozen.WorkHours(d(2020, 4, 17)) != 24*time.Hour {
+		t.Errorf("builder changes not used by later build")
+	}
+}
+
+func TestBusinessCalendarBuilderSettings(t *testing.T) {
+	zone := time.FixedZone("test", 10*60*60)
+	summer := WorkSchedule{}
+	summer[time.Friday] = []WorkInterval{{8 * time.Hour, 12 * time.Hour}}
+	o := ScheduleOverride{Start: d(2020, 6, 1), End: d(2020, 8, 31), Schedule: summer}
+	c := NewBusinessCalendarBuilder().
+		SetLocation(zone).
+		AddLocation(zone).
+		SetLocationMatch(MatchOffset).
+		SetWorkdayFunc(func(date time.Time) bool { return date.Day() != 1 }).
+		AddScheduleOverride(o).
+		AddHoliday(&Holiday{Type: ObservanceBank, Month: time.June, Day: 2, Func: CalcDayOfMonth}).
+		SetHolidayPolicy(HolidayPolicy{Types: []ObservanceType{ObservancePublic}}).
+		Build()
+	summer[time.Friday][0].End = 18 * time.Hour
+
+	if !c.IsApplicable(time.FixedZone("other", 10*60*60)) || c.IsApplicable(time.UTC) {
+		t.Errorf("unexpected locations")
+	}
+	if c.IsWorkday(d(2020, 6, 1)) {
+		t.Errorf("workday func not used")
+	}
+	if !c.IsWorkday(time.Date(2020, 6, 2, 12, 0, 0, 0, zone)) {
+		t.Errorf("holiday policy not used")
+	}
+	if got := c.WorkHours(time.Date(2020, 6, 5, 0, 0, 0, 0, zone)); got != 4*time.Hour {
+		t.Errorf("got: %s; want: 4h", got)
+	}
+	if got, want := c.WorkdayStart(d(2020, 6, 5)), time.Date(2020, 6, 5, 8, 0, 0, 0, zone); !got.Equal(want) {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+
+	c = NewBusinessCalendarBuilder().
+		SetWorkIntervalsFunc(func(date time.Time) []WorkInterval {
+			return []WorkInterval{{10 * time.Hour, 11 * time.Hour}}
+		}).
+		Build()
+	if got := c.WorkHours(d(2020, 6, 5)); got != time.Hour {
+		t.Errorf("got: %s; want: 1h", got)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	bc := NewBusinessCalendar()
+	bc.AddHoliday(&Holiday{Month: time.April, Day: 14, Func: CalcDayOfMonth})
+	bc.SetWeekdayWorkHours(time.Monday, WorkInterval{10 * time.Hour, 12 * time.Hour})
+	c := Freeze(bc)
+
+	bc.Holidays[0].Day = 15
+	bc.Schedule[time.Monday][0].End = 18 * time.Hour
+	bc.SetWorkday(time.Wednesday, false)
+
+	if c.IsWorkday(d(2020, 4, 14)) || !c.IsWorkday(d(2020, 4, 15)) {
+		t.Errorf("calendar changed after freeze")
+	}
+	if got := c.WorkHours(d(2020, 4, 13)); got != 2*time.Hour {
+		t.Errorf("got: %s; want: 2h", got)
+	}
+}
+
+func TestFreezeComposite(t *testing.T) {
+	a := NewBusinessCalendar()
+	a.AddHoliday(&Holiday{Month: time.April, Day: 14, Func: CalcDayOfMonth})
+	b := NewBusinessCalendar()
+	frozen := NewBusinessCalendarBuilder().Build()
+	inner := NewCompositeCalendar(JointOpen, b, frozen)
+	comp := NewCompositeCalendar(JointOpen, a, inner)
+	c := Freeze(&comp.BusinessCalendar)
+
+	a.Holidays[0].Day = 15
+	a.SetWorkday(time.Thursday, false)
+	b.SetWorkHours(10*time.Hour, 12*time.Hour)
+	inner.Members = nil
+	comp.Mode = AnyOpen
+	comp.AddHoliday(&Holiday{Month: time.April, Day: 17, Func: CalcDayOfMonth})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{d(2020, 4, 14), false},
+		{d(2020, 4, 15), true},
+		{d(2020, 4, 16), true},
+		{d(2020, 4, 17), true},
+	}
+	for _, test := range tests {
+		if got := c.IsWorkday(test.t); got != test.want {
+			t.Errorf("%s: got: %t; want: %t", test.t, got, test.want)
+		}
+	}
+	if got := c.WorkHours(d(2020, 4, 15)); got != 8*time.Hour {
+		t.Errorf("got: %s; want: 8h", got)
+	}
+
+	// the composite still works with its live members
+	if comp.IsWorkday(d(2020, 4, 16)) || comp.IsWorkday(d(2020, 4, 17)) {
+		t.Errorf("composite not changed")
+	}
+}
+
+func TestFrozenCalendarConcurrent(t *testing.T) {
+	c := NewBusinessCalendarBuilder().
+		AddHoliday(&Holiday{Month: time.April, Day: 14, Func: CalcDayOfMonth}).
+		Build()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if c.IsWorkday(d(2020, 4, 14)) {
+					t.Errorf("got workday on holiday")
+				}
+				c.AddWorkHours(dt(2020, 4, 1, 9, 0), 80*time.Hour)
+				c.WorkdaysInRange(d(2020, 1, 1), d(2020, 12, 31))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAtomicCalendar(t *testing.T) {
+	build := func(day int) *FrozenCalendar {
+		return NewBusinessCalendarBuilder().
+			AddHoliday(&Holiday{Month: time.April, Day: day, Func: CalcDayOfMonth}).
+			Build()
+	}
+	c1, c2 := build(14), build(15)
+	a := NewAtomicCalendar(c1)
+
+	if a.Load() != c1 || a.Load().IsWorkday(d(2020, 4, 14)) {
+		t.Errorf("initial calendar not used")
+	}
+	if old := a.Swap(c2); old != c1 || a.Load() != c2 {
+		t.Errorf("swap failed")
+	}
+	if !a.Load().IsWorkday(d(2020, 4, 14)) || a.Load().IsWorkday(d(2020, 4, 15)) {
+		t.Errorf("swapped calendar not used")
+	}
+
+	errTest := errors.New("test")
+	if err := a.Reload(func() (*FrozenCalendar, error) { return nil, errTest }); err != errTest || a.Load() != c2 {
+		t.Errorf("got: %v; want: %v and calendar kept", err, errTest)
+	}
+	if err := a.Reload(func() (*FrozenCalendar, error) { return c1, nil }); err != nil || a.Load() != c1 {
+		t.Errorf("got: %v; want: reload", err)
+	}
+
+	// readers see either calendar while it is reloaded
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if i%2 == 0 {
+					a.Reload(func() (*FrozenCalendar, error) { return build(14 + j%2), nil })
+					continue
+				}
+				c := a.Load()
+				if c.IsWorkday(d(2020, 4, 14)) == c.IsWorkday(d(2020, 4, 15)) {
+					t.Errorf("inconsistent calendar")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
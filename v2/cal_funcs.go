@@ -59,12 +59,22 @@ func WeekdayNFrom(t time.Time, day time.Weekday, n int) time.Time {
 //
 // All non-zero results will be at noon in the DefaultLoc location/timezone.
 func WeekdayN(year int, month time.Month, day time.Weekday, n int) time.Time {
+	return WeekdayNIn(year, month, day, n, DefaultLoc)
+}
+
+// WeekdayNIn reports the nth occurrence of a weekday starting in the given
+// year and month in the given location.
+//
+// The value of n is handled the same way as WeekdayN.
+//
+// All non-zero results will be at noon in the given location.
+func WeekdayNIn(year int, month time.Month, day time.Weekday, n int, loc *time.Location) time.Time {
 	if n > 0 {
-		return WeekdayNFrom(time.Date(year, month, 1, 12, 0, 0, 0, DefaultLoc), day, n)
+		return WeekdayNFrom(time.Date(year, month, 1, 12, 0, 0, 0, loc), day, n)
 	} else if n == 0 {
 		return time.Time{}
 	} else {
-		return WeekdayNFrom(time.Date(year, month+1, 0, 12, 0, 0, 0, DefaultLoc), day, n)
+		return WeekdayNFrom(time.Date(year, month+1, 0, 12, 0, 0, 0, loc), day, n)
 	}
 }
 
@@ -86,15 +96,16 @@ func IsWeekdayN(t time.Time, day time.Weekday, n int) bool {
 		return (tDay-1)/7 == (n - 1)
 	}
 
-	want := WeekdayN(tYear, tMonth, day, n)
+	want := WeekdayNIn(tYear, tMonth, day, n, t.Location())
 	wantYear, wantMonth, wantDay := want.Date()
 	return wantYear == tYear && wantMonth == tMonth && wantDay == tDay
 }
 
-// DayStart reports the start of the day in t (sets time fields zero).
+// DayStart reports the start of the day in t (sets time fields zero). If
+// midnight is skipped by a daylight saving time transition, the first instant
+// of the day is returned instead.
 func DayStart(t time.Time) time.Time {
-	year, month, day := t.Date()
-	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	return wallClock(t, 0)
 }
 
 // DayEnd reports the end of the day in t (sets time fields to maximum).
@@ -127,6 +138,30 @@ func ReplaceLocation(t time.Time, loc *time.Location) time.Time {
 	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), loc)
 }
 
+// wallClock reports the time on the same day as date (in date's location) at
+// the wall clock time given by the duration since midnight.
+//
+// Unlike adding the duration to midnight, this isn't affected by daylight
+// saving time transitions earlier in the day. Wall clock times that are
+// skipped by a transition are moved forward by the length of the gap, so the
+// result is always on the same day as date.
+func wallClock(date time.Time, d time.Duration) time.Time {
+	year, month, day := date.Date()
+	h := d / time.Hour
+	d -= h * time.Hour
+	m := d / time.Minute
+	d -= m * time.Minute
+	sec := d / time.Second
+	d -= sec * time.Second
+
+	t := time.Date(year, month, day, int(h), int(m), int(sec), int(d), date.Location())
+	want := time.Date(year, month, day, int(h), int(m), int(sec), int(d), time.UTC)
+	if got := ReplaceLocation(t, time.UTC); got.Before(want) {
+		t = t.Add(want.Sub(got))
+	}
+	return t
+}
+
 // JulianDayNumber reports the Julian Day Number for t. Note that Julian days
 // start at 12:00 UTC.
 func JulianDayNumber(t time.Time) int {
@@ -0,0 +1,109 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"sort"
+	"time"
+)
+
+// WorkInterval represents a period of work within a day, such as 8am-12pm.
+//
+// Start and End are wall clock times measured from midnight, so an interval of
+// 8h-12h is always 8am-12pm, even on days with a daylight saving time
+// transition.
+//
+// Intervals can continue past midnight, such as a night shift from 10pm-6am.
+// These can be written with an End that is less than Start (22h-6h) or
+// greater than 24h (22h-30h). An interval always belongs to the day on which
+// it starts: it is only worked if that day is a workday, and it is counted in
+// that day's WorkHours, WorkdayStart and WorkdayEnd.
+type WorkInterval struct {
+	Start time.Duration // the time of day at which work starts
+	End   time.Duration // the time of day at which work ends
+}
+
+// WorkSchedule holds the work intervals for each day of the week, indexed by
+// time.Weekday.
+//
+// A nil entry means the day's hours are not set by the schedule and the
+// calendar's standard hours are used instead. An empty, non-nil entry means
+// there are no working hours on that day.
+type WorkSchedule [7][]WorkInterval
+
+// ScheduleOverride replaces the work schedule for a range of dates, such as
+// summer hours from June to August. Weekdays with a nil entry in the
+// schedule keep their normal hours.
+type ScheduleOverride struct {
+	Start    time.Time    // the first day of the override; only the date is considered
+	End      time.Time    // the last day of the override; only the date is considered
+	Schedule WorkSchedule // the work intervals used between Start and End
+}
+
+// Contains reports whether the given date is within the override's range.
+// Only the year, month and day of each time are considered.
+func (o *ScheduleOverride) Contains(date time.Time) bool {
+	d := dateNum(date)
+	return d >= dateNum(o.Start) && d <= dateNum(o.End)
+}
+
+// dateNum reports the date of t as a number that sorts in date order.
+func dateNum(t time.Time) int {
+	year, month, day := t.Date()
+	return year*10000 + int(month)*100 + day
+}
+
+// Period represents the time between two instants, including Start but not
+// End.
+type Period struct {
+	Start time.Time // the first instant of the period
+	End   time.Time // the end of the period (exclusive)
+}
+
+// Duration reports the length of the period.
+func (p Period) Duration() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// mergePeriods sorts the periods by start time and merges periods that
+// overlap or touch. Empty periods are removed.
+func mergePeriods(periods []Period) []Period {
+	r := periods[:0]
+	for _, p := range periods {
+		if p.End.After(p.Start) {
+			r = append(r, p)
+		}
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Start.Before(r[j].Start) })
+
+	n := 0
+	for _, p := range r {
+		if n > 0 && !p.Start.After(r[n-1].End) {
+			if p.End.After(r[n-1].End) {
+				r[n-1].End = p.End
+			}
+			continue
+		}
+		r[n] = p
+		n++
+	}
+	return r[:n]
+}
+
+// intersectPeriods reports the periods that are included in both a and b.
+// Both lists must be in ascending order without overlaps.
+func intersectPeriods(a, b []Period) []Period {
+	var r []Period
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := MaxTime(a[i].Start, b[j].Start), MinTime(a[i].End, b[j].End)
+		if end.After(start) {
+			r = append(r, Period{start, end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return r
+}
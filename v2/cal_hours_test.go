@@ -0,0 +1,57 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergePeriods(t *testing.T) {
+	p := func(start, end int) Period {
+		return Period{dt(2020, 4, 1, start, 0), dt(2020, 4, 1, end, 0)}
+	}
+
+	tests := []struct {
+		in   []Period
+		want []Period
+	}{
+		{[]Period{}, []Period{}},
+		{[]Period{p(8, 12)}, []Period{p(8, 12)}},
+		{[]Period{p(13, 17), p(8, 12)}, []Period{p(8, 12), p(13, 17)}},
+		{[]Period{p(8, 12), p(10, 14)}, []Period{p(8, 14)}},
+		{[]Period{p(8, 12), p(12, 14)}, []Period{p(8, 14)}},
+		{[]Period{p(8, 17), p(10, 12)}, []Period{p(8, 17)}},
+		{[]Period{p(8, 8), p(12, 10), p(13, 14)}, []Period{p(13, 14)}},
+	}
+
+	for i, test := range tests {
+		got := mergePeriods(test.in)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("[%d] got: %v; want: %v", i, got, test.want)
+		}
+	}
+}
+
+func TestIntersectPeriods(t *testing.T) {
+	p := func(start, end int) Period {
+		return Period{dt(2020, 4, 1, start, 0), dt(2020, 4, 1, end, 0)}
+	}
+
+	tests := []struct {
+		a, b []Period
+		want []Period
+	}{
+		{nil, []Period{p(8, 12)}, nil},
+		{[]Period{p(8, 12)}, []Period{p(13, 17)}, nil},
+		{[]Period{p(8, 17)}, []Period{p(9, 12), p(13, 18)}, []Period{p(9, 12), p(13, 17)}},
+		{[]Period{p(8, 12), p(13, 17)}, []Period{p(10, 14)}, []Period{p(10, 12), p(13, 14)}},
+	}
+
+	for i, test := range tests {
+		got := intersectPeriods(test.a, test.b)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("[%d] got: %v; want: %v", i, got, test.want)
+		}
+	}
+}
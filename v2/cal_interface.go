@@ -0,0 +1,105 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// HolidayCalendar is the interface implemented by calendars that report
+// holidays, such as Calendar.
+type HolidayCalendar interface {
+	// IsHoliday reports whether a given date is a holiday or an observation
+	// day.
+	IsHoliday(date time.Time) (actual, observed bool, h *Holiday)
+
+	// Occurrences reports the days between the start and end dates
+	// (inclusive) that are holidays or observation days.
+	Occurrences(start, end time.Time) []HolidayOccurrence
+
+	// NextHoliday, PrevHoliday and NextHolidays report the holidays around
+	// a given date.
+	NextHoliday(date time.Time, observed bool) HolidayOccurrence
+	PrevHoliday(date time.Time, observed bool) HolidayOccurrence
+	NextHolidays(date time.Time, n int, observed bool) []HolidayOccurrence
+}
+
+// WorkdayCalendar is the interface implemented by calendars that report
+// whether days are workdays and count them.
+type WorkdayCalendar interface {
+	IsWorkday(date time.Time) bool
+	WorkdaysRemain(date time.Time) int
+	WorkdaysInMonth(year int, month time.Month) int
+	HolidaysInRange(start, end time.Time) int
+	WorkdaysInRange(start, end time.Time) int
+	WorkdayN(year int, month time.Month, n int) int
+}
+
+// WorkdayNavigator is the interface implemented by calendars that find
+// workdays relative to a given date.
+type WorkdayNavigator interface {
+	WorkdaysFrom(start time.Time, offset int) time.Time
+	NextWorkday(date time.Time) time.Time
+	PrevWorkday(date time.Time) time.Time
+	FirstWorkdayOfWeek(date time.Time) time.Time
+	LastWorkdayOfWeek(date time.Time) time.Time
+	FirstWorkdayOfMonth(date time.Time) time.Time
+	LastWorkdayOfMonth(date time.Time) time.Time
+	FirstWorkdayOfQuarter(date time.Time) time.Time
+	LastWorkdayOfQuarter(date time.Time) time.Time
+	FirstWorkdayOfYear(date time.Time) time.Time
+	LastWorkdayOfYear(date time.Time) time.Time
+}
+
+// WorkHoursCalendar is the interface implemented by calendars that report
+// working hours and do arithmetic with them.
+type WorkHoursCalendar interface {
+	IsWorkTime(date time.Time) bool
+	WorkHours(date time.Time) time.Duration
+	WorkdayStart(date time.Time) time.Time
+	WorkdayEnd(date time.Time) time.Time
+	NextWorkdayStart(date time.Time) time.Time
+	NextWorkdayEnd(date time.Time) time.Time
+	PrevWorkdayStart(date time.Time) time.Time
+	PrevWorkdayEnd(date time.Time) time.Time
+	WorkHoursInRange(start, end time.Time) time.Duration
+	AddWorkHours(date time.Time, worked time.Duration) time.Time
+	SubWorkHours(date time.Time, worked time.Duration) time.Time
+	WorkPeriods(start, end time.Time) []Period
+	OffPeriods(start, end time.Time) []Period
+}
+
+// WorkCalendar is the interface implemented by calendars that report
+// holidays, workdays and working hours, such as BusinessCalendar and
+// CompositeCalendar.
+//
+// Functions in this package that accept a WorkCalendar, or one of the smaller
+// interfaces it combines, can be given any implementation, so calendars can
+// be wrapped (e.g. for caching or logging) or replaced with fakes in tests.
+// See the BusinessCalendar methods for the details of each method.
+type WorkCalendar interface {
+	HolidayCalendar
+	WorkdayCalendar
+	WorkdayNavigator
+	WorkHoursCalendar
+}
+
+var (
+	_ HolidayCalendar = (*Calendar)(nil)
+
+	_ HolidayCalendar   = (*BusinessCalendar)(nil)
+	_ WorkdayCalendar   = (*BusinessCalendar)(nil)
+	_ WorkdayNavigator  = (*BusinessCalendar)(nil)
+	_ WorkHoursCalendar = (*BusinessCalendar)(nil)
+	_ WorkCalendar      = (*BusinessCalendar)(nil)
+
+	_ HolidayCalendar   = (*CompositeCalendar)(nil)
+	_ WorkdayCalendar   = (*CompositeCalendar)(nil)
+	_ WorkdayNavigator  = (*CompositeCalendar)(nil)
+	_ WorkHoursCalendar = (*CompositeCalendar)(nil)
+	_ WorkCalendar      = (*CompositeCalendar)(nil)
+
+	_ HolidayCalendar   = (*FrozenCalendar)(nil)
+	_ WorkdayCalendar   = (*FrozenCalendar)(nil)
+	_ WorkdayNavigator  = (*FrozenCalendar)(nil)
+	_ WorkHoursCalendar = (*FrozenCalendar)(nil)
+	_ WorkCalendar      = (*FrozenCalendar)(nil)
+)
@@ -0,0 +1,46 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+// closedCalendar is a WorkCalendar decorator that closes on extra dates.
+type closedCalendar struct {
+	*BusinessCalendar
+	closed map[time.Time]bool
+	calls  int
+}
+
+func (c *closedCalendar) IsWorkday(date time.Time) bool {
+	c.calls++
+	return !c.closed[DayStart(date)] && c.BusinessCalendar.IsWorkday(date)
+}
+
+func TestWorkCalendarDecorator(t *testing.T) {
+	dec := &closedCalendar{
+		BusinessCalendar: NewBusinessCalendar(),
+		closed:           map[time.Time]bool{d(2020, 4, 14): true},
+	}
+
+	var wc WorkCalendar = dec
+	if wc.IsWorkday(d(2020, 4, 14)) || !wc.IsWorkday(d(2020, 4, 15)) {
+		t.Errorf("decorator not used for IsWorkday")
+	}
+
+	comp := NewCompositeCalendar(JointOpen, dec, NewBusinessCalendar())
+	if comp.IsWorkday(d(2020, 4, 14)) || !comp.IsWorkday(d(2020, 4, 15)) {
+		t.Errorf("decorator not used by composite calendar")
+	}
+	if dec.calls < 4 {
+		t.Errorf("got: %d calls; want at least 4", dec.calls)
+	}
+
+	sla := &SLATarget{Calendar: comp, Target: 8 * time.Hour}
+	st := sla.Status(dt(2020, 4, 13, 12, 0), dt(2020, 4, 13, 13, 0), nil)
+	if want := dt(2020, 4, 15, 12, 0); !st.Deadline.Equal(want) {
+		t.Errorf("got: %s; want: %s", st.Deadline, want)
+	}
+}
@@ -0,0 +1,173 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// holidaySearchDays limits how far holiday searches look ahead or back so
+// calendars without future holidays can't loop forever. It's long enough to
+// find holidays that only occur in leap years.
+const holidaySearchDays = 366 * 5
+
+// workdaySearchDays limits how far workday searches look ahead or back so
+// calendars without workdays can't loop forever.
+const workdaySearchDays = 366
+
+// NextHoliday reports the first holiday after the day of date.
+//
+// If observed is true, the first day on which a holiday is observed is
+// reported; otherwise the first day on which a holiday actually occurs is
+// reported. If no holiday is found within five years, the zero
+// HolidayOccurrence (with a nil Holiday) is returned.
+func (c *Calendar) NextHoliday(date time.Time, observed bool) HolidayOccurrence {
+	return c.findHoliday(date, 1, observed)
+}
+
+// PrevHoliday reports the last holiday before the day of date. The value of
+// observed is handled the same way as NextHoliday.
+func (c *Calendar) PrevHoliday(date time.Time, observed bool) HolidayOccurrence {
+	return c.findHoliday(date, -1, observed)
+}
+
+// NextHolidays reports the next n holidays after the day of date in ascending
+// order. The value of observed is handled the same way as NextHoliday. Fewer
+// than n holidays are returned if the search runs out of holidays.
+func (c *Calendar) NextHolidays(date time.Time, n int, observed bool) []HolidayOccurrence {
+	var r []HolidayOccurrence
+	for len(r) < n {
+		h := c.findHoliday(date, 1, observed)
+		if h.Holiday == nil {
+			break
+		}
+		r = append(r, h)
+		date = h.Date
+	}
+	return r
+}
+
+// findHoliday reports the first holiday in the direction of add from the day
+// of date, excluding that day.
+func (c *Calendar) findHoliday(date time.Time, add int, observed bool) HolidayOccurrence {
+	for i := 1; i <= holidaySearchDays; i++ {
+		day := DayStart(addDays(date, i*add))
+		act, obs, h := c.IsHoliday(day)
+		if h != nil && ((observed && obs) || (!observed && act)) {
+			return HolidayOccurrence{Date: day, Holiday: h, Actual: act, Observed: obs}
+		}
+	}
+	return HolidayOccurrence{}
+}
+
+// NextWorkday reports the start of the first workday after the day of date.
+// If there are no workdays within a year, the zero time is returned.
+func (c *BusinessCalendar) NextWorkday(date time.Time) time.Time {
+	return c.findWorkday(addDays(date, 1), workdaySearchDays, 1)
+}
+
+// PrevWorkday reports the start of the last workday before the day of date.
+// If there are no workdays within a year, the zero time is returned.
+func (c *BusinessCalendar) PrevWorkday(date time.Time) time.Time {
+	return c.findWorkday(addDays(date, -1), workdaySearchDays, -1)
+}
+
+// FirstWorkdayOfWeek reports the start of the first workday in the week of
+// date. Weeks start on Monday. If there are no workdays in the week, the zero
+// time is returned.
+func (c *BusinessCalendar) FirstWorkdayOfWeek(date time.Time) time.Time {
+	return c.findWorkday(weekStart(date), 7, 1)
+}
+
+// LastWorkdayOfWeek reports the start of the last workday in the week of
+// date. Weeks end on Sunday. If there are no workdays in the week, the zero
+// time is returned.
+func (c *BusinessCalendar) LastWorkdayOfWeek(date time.Time) time.Time {
+	return c.findWorkday(addDays(weekStart(date), 6), 7, -1)
+}
+
+// FirstWorkdayOfMonth reports the start of the first workday in the month of
+// date. If there are no workdays in the month, the zero time is returned.
+func (c *BusinessCalendar) FirstWorkdayOfMonth(date time.Time) time.Time {
+	return c.firstWorkday(date, 1)
+}
+
+// LastWorkdayOfMonth reports the start of the last workday in the month of
+// date. If there are no workdays in the month, the zero time is returned.
+func (c *BusinessCalendar) LastWorkdayOfMonth(date time.Time) time.Time {
+	return c.lastWorkday(date, 1)
+}
+
+// FirstWorkdayOfQuarter reports the start of the first workday in the
+// calendar quarter of date. If there are no workdays in the quarter, the zero
+// time is returned.
+func (c *BusinessCalendar) FirstWorkdayOfQuarter(date time.Time) time.Time {
+	return c.firstWorkday(date, 3)
+}
+
+// LastWorkdayOfQuarter reports the start of the last workday in the calendar
+// quarter of date. If there are no workdays in the quarter, the zero time is
+// returned.
+func (c *BusinessCalendar) LastWorkdayOfQuarter(date time.Time) time.Time {
+	return c.lastWorkday(date, 3)
+}
+
+// FirstWorkdayOfYear reports the start of the first workday in the year of
+// date. If there are no workdays in the year, the zero time is returned.
+func (c *BusinessCalendar) FirstWorkdayOfYear(date time.Time) time.Time {
+	return c.firstWorkday(date, 12)
+}
+
+// LastWorkdayOfYear reports the start of the last workday in the year of
+// date. If there are no workdays in the year, the zero time is returned.
+func (c *BusinessCalendar) LastWorkdayOfYear(date time.Time) time.Time {
+	return c.lastWorkday(date, 12)
+}
+
+// firstWorkday reports the start of the first workday in the span of months
+// that includes date. Spans start in January and are months long.
+func (c *BusinessCalendar) firstWorkday(date time.Time, months int) time.Time {
+	start := spanStart(date, months)
+	end := start.AddDate(0, months, 0)
+	for day := start; day.Before(end); day = addDays(day, 1) {
+		if c.IsWorkday(day) {
+			return DayStart(day)
+		}
+	}
+	return time.Time{}
+}
+
+// lastWorkday reports the start of the last workday in the span of months
+// that includes date. Spans start in January and are months long.
+func (c *BusinessCalendar) lastWorkday(date time.Time, months int) time.Time {
+	start := spanStart(date, months)
+	for day := addDays(start.AddDate(0, months, 0), -1); !day.Before(start); day = addDays(day, -1) {
+		if c.IsWorkday(day) {
+			return DayStart(day)
+		}
+	}
+	return time.Time{}
+}
+
+// findWorkday reports the start of the first workday in the given number of
+// days from date (inclusive) in the direction of add, or the zero time if
+// there is none.
+func (c *BusinessCalendar) findWorkday(date time.Time, days, add int) time.Time {
+	for i := 0; i < days; i++ {
+		day := addDays(date, i*add)
+		if c.IsWorkday(day) {
+			return DayStart(day)
+		}
+	}
+	return time.Time{}
+}
+
+// weekStart reports noon on the Monday of the week of date.
+func weekStart(date time.Time) time.Time {
+	return addDays(date, -((int(date.Weekday()) + 6) % 7))
+}
+
+// spanStart reports noon on the first day of the span of months that includes
+// date. Spans start in January and are months long.
+func spanStart(date time.Time, months int) time.Time {
+	month := time.Month((int(date.Month())-1)/months*months + 1)
+	return time.Date(date.Year(), month, 1, 12, 0, 0, 0, date.Location())
+}
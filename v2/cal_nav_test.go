@@ -0,0 +1,188 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNextPrevHoliday(t *testing.T) {
+	newYear := &Holiday{
+		Month:    time.January,
+		Day:      1,
+		Observed: []AltDay{{Day: time.Saturday, Offset: -1}, {Day: time.Sunday, Offset: 1}},
+		Func:     CalcDayOfMonth,
+	}
+	july4 := &Holiday{
+		Month:    time.July,
+		Day:      4,
+		Observed: []AltDay{{Day: time.Saturday, Offset: -1}, {Day: time.Sunday, Offset: 1}},
+		Func:     CalcDayOfMonth,
+	}
+	c := &Calendar{Holidays: []*Holiday{newYear, july4}}
+
+	tests := []struct {
+		date     time.Time
+		observed bool
+		next     HolidayOccurrence
+		prev     HolidayOccurrence
+	}{
+		// 4-Jul-2020 is a Saturday, observed on Friday
+		{dt(2020, 5, 1, 12, 0), false, HolidayOccurrence{d(2020, 7, 4), july4, true, false},
+			HolidayOccurrence{d(2020, 1, 1), newYear, true, true}},
+		{dt(2020, 5, 1, 12, 0), true, HolidayOccurrence{d(2020, 7, 3), july4, false, true},
+			HolidayOccurrence{d(2020, 1, 1), newYear, true, true}},
+		// the day of date is excluded
+		{dt(2020, 7, 4, 0, 0), false, HolidayOccurrence{d(2021, 1, 1), newYear, true, true},
+			HolidayOccurrence{d(2020, 1, 1), newYear, true, true}},
+		{dt(2020, 7, 4, 0, 0), true, HolidayOccurrence{d(2021, 1, 1), newYear, true, true},
+			HolidayOccurrence{d(2020, 7, 3), july4, false, true}},
+		// 1-Jan-2022 is a Saturday, observed on Friday 31-Dec-2021
+		{dt(2021, 12, 30, 23, 0), true, HolidayOccurrence{d(2021, 12, 31), newYear, false, true},
+			HolidayOccurrence{d(2021, 7, 5), july4, false, true}},
+		{dt(2022, 1, 2, 8, 0), false, HolidayOccurrence{d(2022, 7, 4), july4, true, true},
+			HolidayOccurrence{d(2022, 1, 1), newYear, true, false}},
+		{dt(2022, 1, 2, 8, 0), true, HolidayOccurrence{d(2022, 7, 4), july4, true, true},
+			HolidayOccurrence{d(2021, 12, 31), newYear, false, true}},
+	}
+
+	for i, test := range tests {
+		if got := c.NextHoliday(test.date, test.observed); got != test.next {
+			t.Errorf("[%d] next got: %v; want: %v", i, got, test.next)
+		}
+		if got := c.PrevHoliday(test.date, test.observed); got != test.prev {
+			t.Errorf("[%d] prev got: %v; want: %v", i, got, test.prev)
+		}
+	}
+
+	want := []HolidayOccurrence{
+		{d(2021, 7, 5), july4, false, true},
+		{d(2021, 12, 31), newYear, false, true},
+		{d(2022, 7, 4), july4, true, true},
+	}
+	if got := c.NextHolidays(d(2021, 7, 1), 3, true); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	ended := &Calendar{Holidays: []*Holiday{{Month: time.May, Day: 1, EndYear: 2019, Func: CalcDayOfMonth}}}
+	if got := ended.NextHoliday(d(2020, 1, 1), false); got.Holiday != nil {
+		t.Errorf("got: %v; want no holiday", got)
+	}
+	if got := ended.PrevHoliday(d(2020, 1, 1), false); got.Date != d(2019, 5, 1) {
+		t.Errorf("got: %v; want: %s", got, d(2019, 5, 1))
+	}
+	if got := ended.NextHolidays(d(2018, 1, 1), 3, false); len(got) != 2 {
+		t.Errorf("got: %v; want 2 holidays", got)
+	}
+}
+
+func TestNextPrevWorkday(t *testing.T) {
+	c := NewBusinessCalendar()
+	c.AddHoliday(&Holiday{Month: time.January, Day: 1, Func: CalcDayOfMonth})
+	none := NewBusinessCalendar()
+	for i := time.Sunday; i <= time.Saturday; i++ {
+		none.SetWorkday(i, false)
+	}
+
+	tests := []struct {
+		c    *BusinessCalendar
+		date time.Time
+		next time.Time
+		prev time.Time
+	}{
+		{c, dt(2020, 4, 1, 12, 0), d(2020, 4, 2), d(2020, 3, 31)},
+		{c, dt(2020, 4, 3, 18, 0), d(2020, 4, 6), d(2020, 4, 2)},
+		{c, dt(2020, 4, 6, 0, 0), d(2020, 4, 7), d(2020, 4, 3)},
+		{c, dt(2020, 12, 31, 23, 0), d(2021, 1, 4), d(2020, 12, 30)},
+		{c, dt(2021, 1, 1, 9, 0), d(2021, 1, 4), d(2020, 12, 31)},
+		{none, dt(2020, 4, 1, 12, 0), time.Time{}, time.Time{}},
+	}
+
+	for i, test := range tests {
+		if got := test.c.NextWorkday(test.date); got != test.next {
+			t.Errorf("[%d] next got: %s; want: %s", i, got, test.next)
+		}
+		if got := test.c.PrevWorkday(test.date); got != test.prev {
+			t.Errorf("[%d] prev got: %s; want: %s", i, got, test.prev)
+		}
+	}
+}
+
+func TestFirstLastWorkday(t *testing.T) {
+	c := NewBusinessCalendar()
+	c.AddHoliday(
+		&Holiday{Month: time.January, Day: 1, Func: CalcDayOfMonth},
+		&Holiday{Month: time.March, Day: 31, Func: CalcDayOfMonth},
+		&Holiday{Month: time.December, Day: 31, Func: CalcDayOfMonth},
+	)
+	none := NewBusinessCalendar()
+	for i := time.Sunday; i <= time.Saturday; i++ {
+		none.SetWorkday(i, false)
+	}
+
+	type span struct {
+		name  string
+		first func(time.Time) time.Time
+		last  func(time.Time) time.Time
+	}
+	spans := func(c *BusinessCalendar) []span {
+		return []span{
+			{"week", c.FirstWorkdayOfWeek, c.LastWorkdayOfWeek},
+			{"month", c.FirstWorkdayOfMonth, c.LastWorkdayOfMonth},
+			{"quarter", c.FirstWorkdayOfQuarter, c.LastWorkdayOfQuarter},
+			{"year", c.FirstWorkdayOfYear, c.LastWorkdayOfYear},
+		}
+	}
+
+	tests := []struct {
+		date  time.Time
+		first []time.Time // week, month, quarter, year
+		last  []time.Time
+	}{
+		// Wednesday 1-Jan-2020 is a holiday
+		{dt(2020, 1, 1, 12, 0),
+			[]time.Time{d(2019, 12, 30), d(2020, 1, 2), d(2020, 1, 2), d(2020, 1, 2)},
+			[]time.Time{d(2020, 1, 3), d(2020, 1, 31), d(2020, 3, 30), d(2020, 12, 30)}},
+		// Sunday is the last day of the week
+		{dt(2020, 3, 29, 23, 0),
+			[]time.Time{d(2020, 3, 23), d(2020, 3, 2), d(2020, 1, 2), d(2020, 1, 2)},
+			[]time.Time{d(2020, 3, 27), d(2020, 3, 30), d(2020, 3, 30), d(2020, 12, 30)}},
+		// the week spans the end of the year
+		{dt(2020, 12, 31, 0, 0),
+			[]time.Time{d(2020, 12, 28), d(2020, 12, 1), d(2020, 10, 1), d(2020, 1, 2)},
+			[]time.Time{d(2020, 12, 30), d(2020, 12, 30), d(2020, 12, 30), d(2020, 12, 30)}},
+		{dt(2020, 8, 15, 12, 0),
+			[]time.Time{d(2020, 8, 10), d(2020, 8, 3), d(2020, 7, 1), d(2020, 1, 2)},
+			[]time.Time{d(2020, 8, 14), d(2020, 8, 31), d(2020, 9, 30), d(2020, 12, 30)}},
+	}
+
+	// Friday 1-Jan-2021 is a holiday too
+	if got, want := c.LastWorkdayOfWeek(d(2020, 12, 28)), d(2020, 12, 30); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+	if got, want := c.FirstWorkdayOfWeek(d(2021, 1, 3)), d(2020, 12, 28); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+
+	for i, test := range tests {
+		for j, s := range spans(c) {
+			if got := s.first(test.date); got != test.first[j] {
+				t.Errorf("[%d] first %s got: %s; want: %s", i, s.name, got, test.first[j])
+			}
+			if got := s.last(test.date); got != test.last[j] {
+				t.Errorf("[%d] last %s got: %s; want: %s", i, s.name, got, test.last[j])
+			}
+		}
+	}
+
+	for _, s := range spans(none) {
+		if got := s.first(d(2020, 4, 1)); !got.IsZero() {
+			t.Errorf("first %s got: %s; want zero time", s.name, got)
+		}
+		if got := s.last(d(2020, 4, 1)); !got.IsZero() {
+			t.Errorf("last %s got: %s; want zero time", s.name, got)
+		}
+	}
+}
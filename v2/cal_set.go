@@ -0,0 +1,98 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// CalendarSet holds several calendars and selects the one that applies to a
+// given time based on its location.
+type CalendarSet struct {
+	Calendars []*Calendar // calendars in order of preference
+}
+
+// AddCalendar adds calendars to the set.
+func (s *CalendarSet) AddCalendar(c ...*Calendar) {
+	s.Calendars = append(s.Calendars, c...)
+}
+
+// Find reports the calendar that applies to the location of t.
+//
+// Calendars that list a matching location are preferred over calendars that
+// don't specify any locations. If no calendar applies, nil is returned.
+func (s *CalendarSet) Find(t time.Time) *Calendar {
+	var fallback *Calendar
+	for _, c := range s.Calendars {
+		if c.Locations == nil {
+			if fallback == nil {
+				fallback = c
+			}
+		} else if c.isApplicable(t.Location(), t.Year()) {
+			return c
+		}
+	}
+	return fallback
+}
+
+// IsHoliday reports whether a given date is a holiday or an observation day
+// in the calendar that applies to its location.
+func (s *CalendarSet) IsHoliday(date time.Time) (actual, observed bool, h *Holiday) {
+	c := s.Find(date)
+	if c == nil {
+		return false, false, nil
+	}
+	return c.IsHoliday(date)
+}
+
+// BusinessCalendarSet holds several business calendars and selects the one
+// that applies to a given time based on its location.
+type BusinessCalendarSet struct {
+	Calendars []*BusinessCalendar // calendars in order of preference
+}
+
+// AddCalendar adds calendars to the set.
+func (s *BusinessCalendarSet) AddCalendar(c ...*BusinessCalendar) {
+	s.Calendars = append(s.Calendars, c...)
+}
+
+// Find reports the business calendar that applies to the location of t.
+//
+// Calendars that list a matching location are preferred over calendars that
+// don't specify any locations. If no calendar applies, nil is returned.
+func (s *BusinessCalendarSet) Find(t time.Time) *BusinessCalendar {
+	var fallback *BusinessCalendar
+	for _, c := range s.Calendars {
+		if c.Locations == nil {
+			if fallback == nil {
+				fallback = c
+			}
+		} else if c.isApplicable(t.Location(), t.Year()) {
+			return c
+		}
+	}
+	return fallback
+}
+
+// IsHoliday reports whether a given date is a holiday or an observation day
+// in the calendar that applies to its location.
+func (s *BusinessCalendarSet) IsHoliday(date time.Time) (actual, observed bool, h *Holiday) {
+	c := s.Find(date)
+	if c == nil {
+		return false, false, nil
+	}
+	return c.IsHoliday(date)
+}
+
+// IsWorkday reports whether a given date is a work day in the calendar that
+// applies to its location. If no calendar applies, false is returned.
+func (s *BusinessCalendarSet) IsWorkday(date time.Time) bool {
+	c := s.Find(date)
+	return c != nil && c.IsWorkday(date)
+}
+
+// IsWorkTime reports whether a given date and time is within working hours in
+// the calendar that applies to its location. If no calendar applies, false is
+// returned.
+func (s *BusinessCalendarSet) IsWorkTime(date time.Time) bool {
+	c := s.Find(date)
+	return c != nil && c.IsWorkTime(date)
+}
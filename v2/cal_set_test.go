@@ -0,0 +1,109 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarSet(t *testing.T) {
+	zone1 := time.FixedZone("test1", -5*3600)
+	zone2 := time.FixedZone("test2", 3*3600)
+	zone3 := time.FixedZone("test3", 3*3600)
+
+	c1 := &Calendar{Name: "c1", Locations: []*time.Location{zone1}}
+	c1.AddHoliday(&Holiday{Name: "h1", Month: time.May, Day: 1, Func: CalcDayOfMonth})
+	c2 := &Calendar{Name: "c2", Locations: []*time.Location{zone2}}
+	c2.AddHoliday(&Holiday{Name: "h2", Month: time.May, Day: 2, Func: CalcDayOfMonth})
+	def := &Calendar{Name: "default"}
+
+	s := &CalendarSet{}
+	if s.Find(time.Date(2021, 5, 1, 12, 0, 0, 0, zone1)) != nil {
+		t.Errorf("expected nil calendar for empty set")
+	}
+	if act, obs, h := s.IsHoliday(time.Date(2021, 5, 1, 12, 0, 0, 0, zone1)); act || obs || h != nil {
+		t.Errorf("expected no holiday for empty set")
+	}
+
+	s.AddCalendar(def, c1, c2)
+	tests := []struct {
+		t       time.Time
+		want    *Calendar
+		wantHol bool
+	}{
+		{time.Date(2021, 5, 1, 12, 0, 0, 0, zone1), c1, true},
+		{time.Date(2021, 5, 2, 12, 0, 0, 0, zone1), c1, false},
+		{time.Date(2021, 5, 2, 12, 0, 0, 0, zone2), c2, true},
+		{time.Date(2021, 5, 1, 12, 0, 0, 0, zone3), def, false},
+		{time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC), def, false},
+	}
+	for i, test := range tests {
+		if got := s.Find(test.t); got != test.want {
+			t.Errorf("[%d] got: %v; want: %v", i, got.Name, test.want.Name)
+		}
+		if act, _, _ := s.IsHoliday(test.t); act != test.wantHol {
+			t.Errorf("[%d] got holiday: %t; want: %t", i, act, test.wantHol)
+		}
+	}
+}
+
+func TestBusinessCalendarSet(t *testing.T) {
+	zone1 := time.FixedZone("test1", -5*3600)
+	zone2 := time.FixedZone("test2", 3*3600)
+
+	c1 := NewBusinessCalendar()
+	c1.Locations = []*time.Location{zone1}
+	c1.AddHoliday(&Holiday{Name: "h1", Month: time.May, Day: 3, Func: CalcDayOfMonth})
+	c2 := NewBusinessCalendar()
+	c2.Locations = []*time.Location{zone2}
+	c2.SetWorkHours(6*time.Hour, 14*time.Hour)
+
+	s := &BusinessCalendarSet{}
+	if s.IsWorkday(time.Date(2021, 5, 4, 12, 0, 0, 0, zone1)) ||
+		s.IsWorkTime(time.Date(2021, 5, 4, 12, 0, 0, 0, zone1)) {
+		t.Errorf("expected no work time for empty set")
+	}
+	if act, obs, h := s.IsHoliday(time.Date(2021, 5, 3, 12, 0, 0, 0, zone1)); act || obs || h != nil {
+		t.Errorf("expected no holiday for empty set")
+	}
+
+	s.AddCalendar(c1, c2)
+	tests := []struct {
+		t            time.Time
+		want         *BusinessCalendar
+		wantHol      bool
+		wantWorkday  bool
+		wantWorkTime bool
+	}{
+		{time.Date(2021, 5, 3, 12, 0, 0, 0, zone1), c1, true, false, false},
+		{time.Date(2021, 5, 3, 12, 0, 0, 0, zone2), c2, false, true, true},
+		{time.Date(2021, 5, 4, 8, 0, 0, 0, zone1), c1, false, true, false},
+		{time.Date(2021, 5, 4, 8, 0, 0, 0, zone2), c2, false, true, true},
+		{time.Date(2021, 5, 4, 8, 0, 0, 0, time.UTC), nil, false, false, false},
+	}
+	for i, test := range tests {
+		if got := s.Find(test.t); got != test.want {
+			t.Errorf("[%d] got: %p; want: %p", i, got, test.want)
+		}
+		if act, _, _ := s.IsHoliday(test.t); act != test.wantHol {
+			t.Errorf("[%d] got holiday: %t; want: %t", i, act, test.wantHol)
+		}
+		if got := s.IsWorkday(test.t); got != test.wantWorkday {
+			t.Errorf("[%d] got workday: %t; want: %t", i, got, test.wantWorkday)
+		}
+		if got := s.IsWorkTime(test.t); got != test.wantWorkTime {
+			t.Errorf("[%d] got work time: %t; want: %t", i, got, test.wantWorkTime)
+		}
+	}
+
+	// the first calendar without locations is the fallback
+	def := NewBusinessCalendar()
+	s.AddCalendar(def, NewBusinessCalendar())
+	if got := s.Find(time.Date(2021, 5, 4, 8, 0, 0, 0, time.UTC)); got != def {
+		t.Errorf("got: %p; want: %p", got, def)
+	}
+	if got := s.Find(time.Date(2021, 5, 4, 8, 0, 0, 0, zone1)); got != c1 {
+		t.Errorf("got: %p; want: %p", got, c1)
+	}
+}
@@ -0,0 +1,163 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"sort"
+	"time"
+)
+
+// SLAState represents the state of a service level agreement target at a
+// point in time.
+type SLAState uint8
+
+// Allowed values for SLAState
+const (
+	SLAOnTrack  SLAState = iota // the target can still be met
+	SLAAtRisk                   // the target can still be met but little time remains
+	SLABreached                 // more working time has elapsed than the target allows
+)
+
+// String returns the name of the SLA state.
+func (s SLAState) String() string {
+	switch s {
+	case SLAOnTrack:
+		return "on track"
+	case SLAAtRisk:
+		return "at risk"
+	case SLABreached:
+		return "breached"
+	}
+	return "unknown"
+}
+
+// Pause represents a period where the SLA clock is stopped, such as while
+// waiting for a reply from the customer. A pause with a zero End is ongoing.
+type Pause struct {
+	Start time.Time // the time the clock was stopped
+	End   time.Time // the time the clock was restarted; zero if still paused
+}
+
+// SLATarget represents a single service level agreement target, such as a
+// first response within 4 working hours.
+//
+// Working time is measured using the target's calendar, so targets for
+// different priorities can use different calendars (e.g. 24x7 for urgent
+// requests and business hours otherwise).
+type SLATarget struct {
+	Name     string            // target name, e.g. "first response"
+	Calendar WorkHoursCalendar // calendar used to measure working time
+	Target   time.Duration     // the working time allowed to meet the target
+	AtRisk   time.Duration     // the target is at risk when no more than this working time remains; disabled if 0
+}
+
+// SLAStatus reports the state of an SLA target at a point in time.
+type SLAStatus struct {
+	Target    *SLATarget    // the target being reported
+	Deadline  time.Time     // the time the target will be breached
+	Elapsed   time.Duration // the working time elapsed, excluding pauses
+	Remaining time.Duration // the working time left to meet the target; negative once breached
+	Paused    bool          // reports whether the clock is paused
+	State     SLAState      // the state of the target
+}
+
+// SLAPolicy represents a set of SLA targets that apply to the same request,
+// such as first response and resolution targets.
+type SLAPolicy struct {
+	Name    string       // policy name, e.g. "priority 1"
+	Targets []*SLATarget // targets that apply to requests with this policy
+}
+
+// Status reports the state of each of the policy's targets at the given time
+// for a request that started at start. See SLATarget.Status.
+func (p *SLAPolicy) Status(start, at time.Time, pauses []Pause) []SLAStatus {
+	r := make([]SLAStatus, len(p.Targets))
+	for i, t := range p.Targets {
+		r[i] = t.Status(start, at, pauses)
+	}
+	return r
+}
+
+// Status reports the state of the target at the given time for a request
+// that started at start.
+//
+// Working time within the pauses doesn't count towards the target. Pauses
+// that are ongoing at the given time are assumed to end at that time when
+// calculating the deadline. Pauses that are known to occur after the given
+// time are included in the deadline.
+func (t *SLATarget) Status(start, at time.Time, pauses []Pause) SLAStatus {
+	r := SLAStatus{Target: t}
+	for _, p := range pauses {
+		if !p.Start.After(at) && (p.End.IsZero() || p.End.After(at)) {
+			r.Paused = true
+		}
+	}
+
+	merged := mergePauses(pauses, at)
+	r.Elapsed = t.Calendar.WorkHoursInRange(start, MaxTime(start, at))
+	for _, p := range merged {
+		from, until := MaxTime(p.Start, start), MinTime(p.End, at)
+		if until.After(from) {
+			r.Elapsed -= t.Calendar.WorkHoursInRange(from, until)
+		}
+	}
+	r.Remaining = t.Target - r.Elapsed
+	r.Deadline = t.deadline(start, merged)
+
+	switch {
+	case r.Remaining < 0:
+		r.State = SLABreached
+	case t.AtRisk > 0 && r.Remaining <= t.AtRisk:
+		r.State = SLAAtRisk
+	default:
+		r.State = SLAOnTrack
+	}
+	return r
+}
+
+// deadline reports the time at which the target's working time is used up
+// when the clock is stopped during the given (merged) pauses.
+func (t *SLATarget) deadline(start time.Time, pauses []Pause) time.Time {
+	cursor := start
+	remaining := t.Target
+	for _, p := range pauses {
+		if !p.End.After(cursor) {
+			continue
+		}
+		if p.Start.After(cursor) {
+			avail := t.Calendar.WorkHoursInRange(cursor, p.Start)
+			if remaining <= avail {
+				break
+			}
+			remaining -= avail
+		}
+		cursor = p.End
+	}
+	return t.Calendar.AddWorkHours(cursor, remaining)
+}
+
+// mergePauses sorts the pauses and merges pauses that overlap. Ongoing pauses
+// are assumed to end at the given time.
+func mergePauses(pauses []Pause, at time.Time) []Pause {
+	r := make([]Pause, 0, len(pauses))
+	for _, p := range pauses {
+		if p.End.IsZero() {
+			p.End = MaxTime(p.Start, at)
+		}
+		if p.End.After(p.Start) {
+			r = append(r, p)
+		}
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Start.Before(r[j].Start) })
+
+	n := 0
+	for _, p := range r {
+		if n > 0 && !p.Start.After(r[n-1].End) {
+			r[n-1].End = MaxTime(r[n-1].End, p.End)
+			continue
+		}
+		r[n] = p
+		n++
+	}
+	return r[:n]
+}
@@ -0,0 +1,119 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSLAStatus(t *testing.T) {
+	hol := &Holiday{Month: time.April, Day: 6, Func: CalcDayOfMonth}
+	business := NewBusinessCalendar()
+	business.AddHoliday(hol)
+	always := New24x7BusinessCalendar()
+
+	response := &SLATarget{Name: "first response", Calendar: business, Target: 4 * time.Hour, AtRisk: time.Hour}
+	resolution := &SLATarget{Name: "resolution", Calendar: business, Target: 24 * time.Hour}
+	urgent := &SLATarget{Name: "urgent", Calendar: always, Target: 8 * time.Hour, AtRisk: 2 * time.Hour}
+
+	start := dt(2020, 4, 1, 15, 0) // Wednesday
+
+	tests := []struct {
+		target        *SLATarget
+		at            time.Time
+		pauses        []Pause
+		wantDeadline  time.Time
+		wantElapsed   time.Duration
+		wantRemaining time.Duration
+		wantPaused    bool
+		wantState     SLAState
+	}{
+		{response, start, nil, dt(2020, 4, 2, 11, 0), 0, 4 * time.Hour, false, SLAOnTrack},
+		{response, dt(2020, 4, 1, 20, 0), nil, dt(2020, 4, 2, 11, 0), 2 * time.Hour, 2 * time.Hour, false, SLAOnTrack},
+		{response, dt(2020, 4, 2, 10, 0), nil, dt(2020, 4, 2, 11, 0), 3 * time.Hour, time.Hour, false, SLAAtRisk},
+		{response, dt(2020, 4, 2, 11, 0), nil, dt(2020, 4, 2, 11, 0), 4 * time.Hour, 0, false, SLAAtRisk},
+		{response, dt(2020, 4, 2, 12, 0), nil, dt(2020, 4, 2, 11, 0), 5 * time.Hour, -time.Hour, false, SLABreached},
+
+		// paused while waiting for the customer
+		{response, dt(2020, 4, 2, 12, 0),
+			[]Pause{{dt(2020, 4, 1, 16, 0), dt(2020, 4, 2, 10, 0)}},
+			dt(2020, 4, 2, 13, 0), 3 * time.Hour, time.Hour, false, SLAAtRisk},
+		// ongoing pause; the deadline assumes the clock restarts now
+		{response, dt(2020, 4, 2, 12, 0),
+			[]Pause{{Start: dt(2020, 4, 1, 16, 0)}},
+			dt(2020, 4, 2, 15, 0), time.Hour, 3 * time.Hour, true, SLAOnTrack},
+		// overlapping pauses are only counted once
+		{response, dt(2020, 4, 2, 12, 0),
+			[]Pause{{dt(2020, 4, 1, 16, 0), dt(2020, 4, 2, 10, 0)}, {dt(2020, 4, 2, 9, 0), dt(2020, 4, 2, 11, 0)}},
+			dt(2020, 4, 2, 14, 0), 2 * time.Hour, 2 * time.Hour, false, SLAOnTrack},
+		// known future pause moves the deadline
+		{response, dt(2020, 4, 1, 16, 0),
+			[]Pause{{dt(2020, 4, 2, 9, 0), dt(2020, 4, 2, 10, 0)}},
+			dt(2020, 4, 2, 12, 0), time.Hour, 3 * time.Hour, false, SLAOnTrack},
+		// pauses before the start or after the deadline don't matter
+		{response, dt(2020, 4, 1, 16, 0),
+			[]Pause{{dt(2020, 3, 31, 9, 0), dt(2020, 3, 31, 10, 0)}, {dt(2020, 4, 3, 9, 0), dt(2020, 4, 3, 10, 0)}},
+			dt(2020, 4, 2, 11, 0), time.Hour, 3 * time.Hour, false, SLAOnTrack},
+
+		// weekend and holiday
+		{resolution, dt(2020, 4, 3, 17, 0), nil, dt(2020, 4, 7, 15, 0), 18 * time.Hour, 6 * time.Hour, false, SLAOnTrack},
+		{resolution, dt(2020, 4, 8, 9, 0), nil, dt(2020, 4, 7, 15, 0), 26 * time.Hour, -2 * time.Hour, false, SLABreached},
+
+		// 24x7 calendar
+		{urgent, dt(2020, 4, 1, 21, 0), nil, dt(2020, 4, 1, 23, 0), 6 * time.Hour, 2 * time.Hour, false, SLAAtRisk},
+		{urgent, dt(2020, 4, 2, 0, 0),
+			[]Pause{{dt(2020, 4, 1, 16, 0), dt(2020, 4, 1, 18, 0)}},
+			dt(2020, 4, 2, 1, 0), 7 * time.Hour, time.Hour, false, SLAAtRisk},
+	}
+
+	for i, test := range tests {
+		got := test.target.Status(start, test.at, test.pauses)
+		if got.Target != test.target || !got.Deadline.Equal(test.wantDeadline) || got.Elapsed != test.wantElapsed ||
+			got.Remaining != test.wantRemaining || got.Paused != test.wantPaused || got.State != test.wantState {
+			t.Errorf("[%d] got: %s, %s, %s, %t, %s; want: %s, %s, %s, %t, %s", i,
+				got.Deadline, got.Elapsed, got.Remaining, got.Paused, got.State,
+				test.wantDeadline, test.wantElapsed, test.wantRemaining, test.wantPaused, test.wantState)
+		}
+	}
+}
+
+func TestSLAPolicyStatus(t *testing.T) {
+	c := NewBusinessCalendar()
+	p := &SLAPolicy{
+		Name: "standard",
+		Targets: []*SLATarget{
+			{Name: "first response", Calendar: c, Target: 2 * time.Hour},
+			{Name: "resolution", Calendar: c, Target: 16 * time.Hour},
+		},
+	}
+
+	got := p.Status(dt(2020, 4, 1, 9, 0), dt(2020, 4, 1, 12, 0), nil)
+	if len(got) != 2 {
+		t.Fatalf("got: %d statuses; want: 2", len(got))
+	}
+	if got[0].Target != p.Targets[0] || got[0].State != SLABreached || !got[0].Deadline.Equal(dt(2020, 4, 1, 11, 0)) {
+		t.Errorf("got: %s, %s; want: breached, %s", got[0].State, got[0].Deadline, dt(2020, 4, 1, 11, 0))
+	}
+	if got[1].Target != p.Targets[1] || got[1].State != SLAOnTrack || !got[1].Deadline.Equal(dt(2020, 4, 2, 17, 0)) {
+		t.Errorf("got: %s, %s; want: on track, %s", got[1].State, got[1].Deadline, dt(2020, 4, 2, 17, 0))
+	}
+}
+
+func TestSLAStateString(t *testing.T) {
+	tests := []struct {
+		s    SLAState
+		want string
+	}{
+		{SLAOnTrack, "on track"},
+		{SLAAtRisk, "at risk"},
+		{SLABreached, "breached"},
+		{SLAState(99), "unknown"},
+	}
+
+	for _, test := range tests {
+		if got := test.s.String(); got != test.want {
+			t.Errorf("got: %s; want: %s", got, test.want)
+		}
+	}
+}
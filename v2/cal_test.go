@@ -3,6 +3,7 @@
 package cal
 
 import (
+	"reflect"
 	"testing"
 	"time"
 )
@@ -12,24 +13,61 @@ func TestIsApplicable(t *testing.T) {
 	zone2 := time.FixedZone("test2", 3)
 
 	tests := []struct {
-		c    Calendar
+		c    *Calendar
 		loc  *time.Location
 		want bool
 	}{
-		{Calendar{}, time.UTC, true},
-		{Calendar{Locations: []*time.Location{time.UTC}}, time.UTC, true},
-		{Calendar{Locations: []*time.Location{zone1}}, time.UTC, false},
-		{Calendar{Locations: []*time.Location{zone1, zone2}}, zone2, true},
+		{&Calendar{}, time.UTC, true},
+		{&Calendar{Locations: []*time.Location{time.UTC}}, time.UTC, true},
+		{&Calendar{Locations: []*time.Location{zone1}}, time.UTC, false},
+		{&Calendar{Locations: []*time.Location{zone1, zone2}}, zone2, true},
 	}
 
 	for _, test := range tests {
 		got := test.c.IsApplicable(test.loc)
 		if got != test.want {
-			t.Errorf("got: %t; want: %t (%v)", got, test.want, test.c)
+			t.Errorf("got: %t; want: %t (%v)", got, test.want, test.c.Locations)
 		}
 	}
 }
 
+func TestIsApplicableMatch(t *testing.T) {
+	berlin1, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	berlin2 := time.FixedZone("Europe/Berlin", 3600)
+	paris, _ := time.LoadLocation("Europe/Paris")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	cet := time.FixedZone("CET", 3600)
+
+	tests := []struct {
+		c    *Calendar
+		loc  *time.Location
+		want bool
+	}{
+		{&Calendar{Locations: []*time.Location{berlin1}}, berlin1, true},
+		{&Calendar{Locations: []*time.Location{berlin1}}, berlin2, true},
+		{&Calendar{Locations: []*time.Location{berlin1}}, paris, false},
+		{&Calendar{Locations: []*time.Location{berlin1}, LocationMatch: MatchOffset}, paris, true},
+		{&Calendar{Locations: []*time.Location{berlin1}, LocationMatch: MatchOffset}, cet, false},
+		{&Calendar{Locations: []*time.Location{berlin1}, LocationMatch: MatchOffset}, tokyo, false},
+	}
+
+	for i, test := range tests {
+		got := test.c.IsApplicable(test.loc)
+		if got != test.want {
+			t.Errorf("[%d] got: %t; want: %t", i, got, test.want)
+		}
+	}
+
+	c := &Calendar{Locations: []*time.Location{berlin1}}
+	c.AddHoliday(&Holiday{Month: time.May, Day: 1, Func: CalcDayOfMonth})
+	if act, _, _ := c.IsHoliday(time.Date(2021, 5, 1, 12, 0, 0, 0, berlin2)); !act {
+		t.Errorf("expected holiday for separately loaded location")
+	}
+}
+
 func TestAddHoliday(t *testing.T) {
 	c := Calendar{}
 	c.AddHoliday(&Holiday{})
@@ -99,6 +137,39 @@ func TestIsHoliday(t *testing.T) {
 	}
 }
 
+func TestIsHolidayLocation(t *testing.T) {
+	zone1 := time.FixedZone("test1", -10*60*60)
+	zone2 := time.FixedZone("test2", 14*60*60)
+
+	hol := &Holiday{Month: time.July, Day: 4, Func: CalcDayOfMonth}
+
+	saved := DefaultLoc
+	defer func() { DefaultLoc = saved }()
+	DefaultLoc = zone2
+
+	tests := []struct {
+		c       *Calendar
+		date    time.Time
+		wantAct bool
+	}{
+		// only the date in its own location matters
+		{&Calendar{Holidays: []*Holiday{hol}}, time.Date(2015, 7, 4, 0, 0, 0, 0, zone1), true},
+		{&Calendar{Holidays: []*Holiday{hol}}, time.Date(2015, 7, 4, 23, 59, 59, 0, zone1), true},
+		{&Calendar{Holidays: []*Holiday{hol}}, time.Date(2015, 7, 4, 0, 0, 0, 0, zone2), true},
+		{&Calendar{Holidays: []*Holiday{hol}}, time.Date(2015, 7, 4, 23, 59, 59, 0, zone2), true},
+		{&Calendar{Holidays: []*Holiday{hol}}, time.Date(2015, 7, 4, 12, 0, 0, 0, zone2).In(zone1), false},
+		{&Calendar{Holidays: []*Holiday{hol}, Location: zone1}, time.Date(2015, 7, 4, 23, 0, 0, 0, zone2), true},
+		{&Calendar{Holidays: []*Holiday{hol}, Location: zone2}, time.Date(2015, 7, 3, 23, 0, 0, 0, zone1), false},
+	}
+
+	for i, test := range tests {
+		gotAct, _, _ := test.c.IsHoliday(test.date)
+		if gotAct != test.wantAct {
+			t.Errorf("[%d] gotAct: %t, wantAct: %t", i, gotAct, test.wantAct)
+		}
+	}
+}
+
 func TestIsHolidayCrossYearBoundaries(t *testing.T) {
 	hol := &Holiday{
 		Type:  ObservancePublic,
@@ -173,3 +244,33 @@ func TestIsHolidayCrossYearBoundaries(t *testing.T) {
 		}
 	}
 }
+
+func TestOccurrences(t *testing.T) {
+	hol1 := &Holiday{
+		Month:    time.July,
+		Day:      4,
+		Observed: []AltDay{{Day: time.Saturday, Offset: -1}},
+		Func:     CalcDayOfMonth,
+	}
+	hol2 := &Holiday{Month: time.July, Day: 14, Func: CalcDayOfMonth}
+	c := &Calendar{Holidays: []*Holiday{hol1, hol2}}
+
+	want := []HolidayOccurrence{
+		{d(2020, 7, 3), hol1, false, true},
+		{d(2020, 7, 4), hol1, true, false},
+		{d(2020, 7, 14), hol2, true, true},
+	}
+
+	for _, got := range [][]HolidayOccurrence{
+		c.Occurrences(dt(2020, 7, 1, 12, 0), dt(2020, 7, 14, 0, 0)),
+		c.Occurrences(d(2020, 7, 14), d(2020, 7, 1)),
+	} {
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got: %v; want: %v", got, want)
+		}
+	}
+
+	if got := c.Occurrences(d(2020, 7, 5), d(2020, 7, 13)); got != nil {
+		t.Errorf("got: %v; want: nil", got)
+	}
+}
@@ -0,0 +1,424 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Package calhttp provides an http.Handler that answers holiday and business
+// day queries with JSON, so that services written in other languages can use
+// the same calendars as Go services.
+//
+// The handler serves the calendars of registered countries. Import the
+// country packages to serve, or the all package to serve every country:
+//
+//	import _ "github.com/rickar/cal/v2/all"
+//
+//	http.Handle("/cal/", http.StripPrefix("/cal", calhttp.NewHandler()))
+//
+// The endpoints are described by the OpenAPI document served at
+// /openapi.json.
+package calhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/internal/calquery"
+)
+
+// Handler answers calendar queries. Create one with NewHandler.
+//
+// The endpoints are:
+//
+//	GET /countries      registered countries and their subdivisions
+//	GET /holidays       holidays in a year or date range
+//	GET /workday        whether a day is a workday, and its holiday if any
+//	GET /workdays/add   the date a number of workdays away from a date
+//	GET /work-hours     working hours between two times
+//	GET /openapi.json   OpenAPI description of the endpoints
+//
+// Calendars are chosen with the calendar query parameter using a country or
+// subdivision code such as "gb" or "DE-BY". Codes separated by commas, such
+// as "us,ecb", combine calendars so that a day is only a workday if it is a
+// workday in all of them.
+//
+// To bound the work done for a request, date ranges may span at most
+// MaxRangeYears years and at most MaxWorkdays workdays may be added.
+//
+// Errors are reported with a 4xx status and a JSON object holding an error
+// message.
+type Handler struct {
+	// Calendar creates the calendar for a single code. If nil,
+	// cal.NewBusinessCalendarFor is used. Return nil for unknown codes.
+	Calendar func(code string) *cal.BusinessCalendar
+}
+
+// Limits on the work done for a request.
+const (
+	MaxRangeYears = 10    // the longest span of /holidays and /work-hours ranges
+	MaxWorkdays   = 10000 // the largest number of workdays added by /workdays/add
+)
+
+// NewHandler creates a new Handler for the registered countries.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// errorResponse is the body of error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// requestError is an error with the HTTP status to report it with.
+type requestError struct {
+	status int
+	msg    string
+}
+
+func (e *requestError) Error() string {
+	return e.msg
+}
+
+// badRequest creates a requestError for invalid parameters.
+func badRequest(format string, a ...interface{}) error {
+	return &requestError{http.StatusBadRequest, fmt.Sprintf(format, a...)}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var fn func(q *query) (interface{}, error)
+	switch r.URL.Path {
+	case "/countries":
+		fn = h.countries
+	case "/holidays":
+		fn = h.holidays
+	case "/workday":
+		fn = h.workday
+	case "/workdays/add":
+		fn = h.addWorkdays
+	case "/work-hours":
+		fn = h.workHours
+	case "/openapi.json":
+		fn = func(*query) (interface{}, error) { return json.RawMessage(openAPI), nil }
+	default:
+		writeJSON(w, http.StatusNotFound, errorResponse{"not found: " + r.URL.Path})
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"method not allowed: " + r.Method})
+		return
+	}
+
+	v, err := fn(&query{h: h, r: r})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+// writeError writes an error response with the status of a requestError, or
+// 500 for any other error.
+func writeError(w http.ResponseWriter, err error) {
+	var re *requestError
+	if !errors.As(err, &re) {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{"internal error"})
+		return
+	}
+	writeJSON(w, re.status, errorResponse{re.Error()})
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+// query reads the parameters of a request.
+type query struct {
+	h   *Handler
+	r   *http.Request
+	loc *time.Location
+}
+
+// get reports the value of a query parameter.
+func (q *query) get(name string) string {
+	return q.r.URL.Query().Get(name)
+}
+
+// location reports the location selected by the tz parameter; UTC if unset.
+func (q *query) location() (*time.Location, error) {
+	if q.loc == nil {
+		tz := q.get("tz")
+		if tz == "" {
+			tz = "UTC"
+		}
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, badRequest("unknown time zone %q", tz)
+		}
+		q.loc = loc
+	}
+	return q.loc, nil
+}
+
+// date reports the value of a required date parameter such as 2026-12-24.
+func (q *query) date(name string) (time.Time, error) {
+	loc, err := q.location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	s := q.get(name)
+	t, err := calquery.ParseDate(s, loc)
+	if err != nil {
+		return t, badRequest("invalid %s %q; use the form 2006-01-02", name, s)
+	}
+	return t, nil
+}
+
+// dateTime reports the value of a required date or date and time parameter
+// such as 2026-12-24T09:30. Times with an offset keep it; others are in the
+// location of the tz parameter.
+func (q *query) dateTime(name string) (time.Time, error) {
+	loc, err := q.location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	s := q.get(name)
+	if t, ok := calquery.ParseTime(s, loc); ok {
+		return t, nil
+	}
+	return time.Time{}, badRequest("invalid %s %q; use the form 2006-01-02T15:04", name, s)
+}
+
+// checkRange reports an error if the range between two parameters is reversed
+// or longer than MaxRangeYears.
+func checkRange(from, to string, start, end time.Time) error {
+	if end.Before(start) {
+		return badRequest("%s must not be before %s", to, from)
+	}
+	if end.After(start.AddDate(MaxRangeYears, 0, 0)) {
+		return badRequest("%s and %s must be at most %d years apart", from, to, MaxRangeYears)
+	}
+	return nil
+}
+
+// integer reports the value of a required integer parameter.
+func (q *query) integer(name string) (int, error) {
+	s := q.get(name)
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, badRequest("invalid %s %q", name, s)
+	}
+	return n, nil
+}
+
+// calendar creates the calendar selected by the calendar and hours
+// parameters.
+func (q *query) calendar() (cal.WorkCalendar, error) {
+	start, end := 9*time.Hour, 17*time.Hour
+	if s := q.get("hours"); s != "" {
+		var ok bool
+		if start, end, ok = calquery.ParseHours(s); !ok {
+			return nil, badRequest("invalid hours %q; use the form 09:00-17:00 or 22:00-06:00", s)
+		}
+	}
+
+	codes := calquery.SplitCodes(q.get("calendar"))
+	if len(codes) == 0 {
+		return nil, badRequest("missing calendar")
+	}
+	c, err := calquery.Calendar(codes, q.h.Calendar, start, end)
+	if err != nil {
+		// unknown codes are the only error
+		return nil, &requestError{http.StatusNotFound, err.Error()}
+	}
+	return c, nil
+}
+
+// name reports the holiday's name in the languages of the lang parameter.
+func (q *query) name(h *cal.Holiday) string {
+	if lang := q.get("lang"); lang != "" {
+		return h.NameIn(strings.Split(lang, ",")...)
+	}
+	return h.Name
+}
+
+// Country is a registered country in a /countries response.
+type Country struct {
+	Code         string        `json:"code"`
+	Alpha3       string        `json:"alpha3"`
+	Name         string        `json:"name"`
+	Subdivisions []Subdivision `json:"subdivisions"`
+}
+
+// Subdivision is a region of a country in a /countries response.
+type Subdivision struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+func (h *Handler) countries(q *query) (interface{}, error) {
+	r := []Country{}
+	for _, c := range cal.Countries() {
+		rc := Country{Code: c.Code, Alpha3: c.Alpha3, Name: c.Name, Subdivisions: []Subdivision{}}
+		for _, s := range c.Subdivisions {
+			rc.Subdivisions = append(rc.Subdivisions, Subdivision{s.Code, s.Name})
+		}
+		r = append(r, rc)
+	}
+	return r, nil
+}
+
+// Holiday is a holiday in a /holidays or /workday response.
+type Holiday struct {
+	Date     string `json:"date"`
+	Weekday  string `json:"weekday"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Actual   bool   `json:"actual"`   // the holiday occurs on the day
+	Observed bool   `json:"observed"` // the holiday is observed on the day
+}
+
+// holiday creates the response for a holiday on the given date.
+func (q *query) holiday(date time.Time, h *cal.Holiday, act, obs bool) *Holiday {
+	return &Holiday{
+		Date:     date.Format(calquery.DateLayout),
+		Weekday:  date.Weekday().String(),
+		ID:       h.ID,
+		Name:     q.name(h),
+		Type:     calquery.TypeName(h.Type),
+		Actual:   act,
+		Observed: obs,
+	}
+}
+
+func (h *Handler) holidays(q *query) (interface{}, error) {
+	c, err := q.calendar()
+	if err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if q.get("year") != "" {
+		year, err := q.integer("year")
+		if err != nil {
+			return nil, err
+		}
+		loc, err := q.location()
+		if err != nil {
+			return nil, err
+		}
+		start = time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		end = time.Date(year, time.December, 31, 0, 0, 0, 0, loc)
+	} else {
+		if start, err = q.date("from"); err != nil {
+			return nil, err
+		}
+		if end, err = q.date("to"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkRange("from", "to", start, end); err != nil {
+		return nil, err
+	}
+
+	r := []*Holiday{}
+	for _, o := range c.Occurrences(start, end) {
+		r = append(r, q.holiday(o.Date, o.Holiday, o.Actual, o.Observed))
+	}
+	return r, nil
+}
+
+// Workday is a /workday response.
+type Workday struct {
+	Date    string   `json:"date"`
+	Workday bool     `json:"workday"`
+	Holiday *Holiday `json:"holiday"` // the holiday on the day, or null
+}
+
+func (h *Handler) workday(q *query) (interface{}, error) {
+	c, err := q.calendar()
+	if err != nil {
+		return nil, err
+	}
+	date, err := q.date("date")
+	if err != nil {
+		return nil, err
+	}
+	r := &Workday{Date: date.Format(calquery.DateLayout), Workday: c.IsWorkday(date)}
+	if act, obs, hol := c.IsHoliday(date); hol != nil {
+		r.Holiday = q.holiday(date, hol, act, obs)
+	}
+	return r, nil
+}
+
+// AddWorkdays is a /workdays/add response.
+type AddWorkdays struct {
+	Date   string `json:"date"`
+	Days   int    `json:"days"`
+	Result string `json:"result"`
+}
+
+func (h *Handler) addWorkdays(q *query) (interface{}, error) {
+	c, err := q.calendar()
+	if err != nil {
+		return nil, err
+	}
+	date, err := q.date("date")
+	if err != nil {
+		return nil, err
+	}
+	n, err := q.integer("days")
+	if err != nil {
+		return nil, err
+	}
+	if n > MaxWorkdays || n < -MaxWorkdays {
+		return nil, badRequest("days must be between %d and %d", -MaxWorkdays, MaxWorkdays)
+	}
+	return &AddWorkdays{
+		Date:   date.Format(calquery.DateLayout),
+		Days:   n,
+		Result: c.WorkdaysFrom(date, n).Format(calquery.DateLayout),
+	}, nil
+}
+
+// WorkHours is a /work-hours response.
+type WorkHours struct {
+	Start    string  `json:"start"`
+	End      string  `json:"end"`
+	Duration string  `json:"duration"` // Go duration, e.g. "26h30m0s"
+	Hours    float64 `json:"hours"`
+}
+
+func (h *Handler) workHours(q *query) (interface{}, error) {
+	c, err := q.calendar()
+	if err != nil {
+		return nil, err
+	}
+	start, err := q.dateTime("start")
+	if err != nil {
+		return nil, err
+	}
+	end, err := q.dateTime("end")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRange("start", "end", start, end); err != nil {
+		return nil, err
+	}
+	d := c.WorkHoursInRange(start, end)
+	return &WorkHours{
+		Start:    start.Format(calquery.TimeLayout),
+		End:      end.Format(calquery.TimeLayout),
+		Duration: d.String(),
+		Hours:    d.Hours(),
+	}, nil
+}
@@ -0,0 +1,186 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package calhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal/v2"
+	_ "github.com/rickar/cal/v2/all"
+)
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(NewHandler())
+	defer srv.Close()
+
+	tests := []struct {
+		method string
+		path   string
+		status int
+		want   []string
+	}{
+		{"GET", "/holidays?calendar=DE-BY&year=2027&lang=en", 200, []string{
+			`"date": "2027-01-06",`, `"weekday": "Wednesday",`, `"id": "de/heilige-drei-koenige",`,
+			`"name": "Epiphany",`, `"type": "public",`,
+		}},
+		{"GET", "/holidays?calendar=gb&from=2026-12-26&to=2026-12-28", 200, []string{
+			`"date": "2026-12-26",`, `"date": "2026-12-28",`, `"actual": false,`,
+		}},
+		{"GET", "/holidays?calendar=gb&from=2026-12-29&to=2026-12-30", 200, []string{"[]"}},
+		{"GET", "/holidays?calendar=gb&year=x", 400, []string{`"error": "invalid year \"x\""`}},
+		{"GET", "/holidays?calendar=gb&year=2026&tz=Nowhere", 400, []string{`unknown time zone \"Nowhere\"`}},
+		{"GET", "/holidays?calendar=gb&from=x", 400, []string{`invalid from \"x\"`}},
+		{"GET", "/holidays?calendar=gb&from=2026-01-01", 400, []string{`invalid to \"\"`}},
+		{"GET", "/holidays?year=2026", 400, []string{`"error": "missing calendar"`}},
+		{"GET", "/holidays?calendar=gb&from=2020-01-01&to=2030-01-01", 200, []string{`"date": "2030-01-01",`}},
+		{"GET", "/holidays?calendar=gb&from=0001-01-01&to=9999-12-31", 400, []string{`"error": "from and to must be at most 10 years apart"`}},
+		{"GET", "/holidays?calendar=gb&from=2400-01-01&to=2000-01-01", 400, []string{`"error": "to must not be before from"`}},
+		{"GET", "/holidays?calendar=gb&from=2026-12-26&to=2026-12-26", 200, []string{`"date": "2026-12-26",`}},
+		{"GET", "/holidays?calendar=xx&year=2026", 404, []string{`unknown calendar \"xx\"`}},
+
+		{"GET", "/workday?calendar=gb&date=2026-12-24", 200, []string{`"workday": true,`, `"holiday": null`}},
+		{"GET", "/workday?calendar=gb&date=2026-12-28&lang=fr", 200, []string{
+			`"workday": false,`, `"id": "gb/boxing-day",`, `"observed": true`,
+		}},
+		{"GET", "/workday?calendar=gb&date=2026-12-24&tz=Nowhere", 400, []string{"unknown time zone"}},
+		{"GET", "/workday?calendar=gb&date=24.12.2026", 400, []string{`invalid date \"24.12.2026\"`}},
+		{"GET", "/workday?date=2026-12-24", 400, []string{"missing calendar"}},
+
+		{"GET", "/workdays/add?calendar=us%2Becb&date=2026-12-22&days=10", 200, []string{`"result": "2027-01-07"`}},
+		{"GET", "/workdays/add?calendar=us+ecb&date=2026-12-22&days=10", 200, []string{`"result": "2027-01-07"`}},
+		{"GET", "/workdays/add?calendar=us,ecb&date=2026-12-28&days=-3", 200, []string{`"days": -3,`, `"result": "2026-12-22"`}},
+		{"GET", "/workdays/add?calendar=us&date=2026-12-28&days=x", 400, []string{`invalid days \"x\"`}},
+		{"GET", "/workdays/add?calendar=us&date=2026-12-28&days=10000", 200, []string{`"days": 10000,`}},
+		{"GET", "/workdays/add?calendar=us&date=2026-12-28&days=2000000000", 400, []string{`"error": "days must be between -10000 and 10000"`}},
+		{"GET", "/workdays/add?calendar=us&date=2026-12-28&days=-10001", 400, []string{"days must be between"}},
+		{"GET", "/workdays/add?calendar=us&date=2026-12-28&days=-10000", 200, []string{`"days": -10000,`}},
+		{"GET", "/workdays/add?calendar=us&date=x&days=1", 400, []string{`invalid date \"x\"`}},
+		{"GET", "/workdays/add?date=2026-12-28&days=1", 400, []string{"missing calendar"}},
+
+		{"GET", "/work-hours?calendar=us&hours=08:00-16:00&start=2026-12-22T10:00&end=2026-12-28T12:00", 200, []string{
+			`"start": "2026-12-22T10:00:00Z",`, `"duration": "26h0m0s",`, `"hours": 26`,
+		}},
+		{"GET", "/work-hours?calendar=us&hours=00:00-24:00&start=2026-12-22&end=2026-12-23T06:30:00", 200, []string{`"hours": 30.5`}},
+		{"GET", "/work-hours?calendar=us&start=2026-12-22T10:00:00%2B01:00&end=2026-12-22T12:00:00Z", 200, []string{`"hours": 3`}},
+		{"GET", "/work-hours?calendar=us&start=2026-12-22T10:00&end=2026-12-22T12:00&tz=America/New_York", 200, []string{
+			`"start": "2026-12-22T10:00:00-05:00",`, `"hours": 2`,
+		}},
+		{"GET", "/work-hours?calendar=us&hours=9-5&start=2026-12-22&end=2026-12-23", 400, []string{`invalid hours \"9-5\"`}},
+		{"GET", "/work-hours?calendar=us&hours=09:00&start=2026-12-22&end=2026-12-23", 400, []string{"invalid hours"}},
+		{"GET", "/work-hours?calendar=us&hours=09:00-09:00&start=2026-12-22&end=2026-12-23", 400, []string{"invalid hours"}},
+		{"GET", "/work-hours?calendar=us&hours=17:00-09:00&start=2026-12-22&end=2026-12-23", 200, []string{`"hours": 16`}},
+		{"GET", "/work-hours?start=2026-12-22&end=2026-12-23", 400, []string{"missing calendar"}},
+		{"GET", "/work-hours?calendar=us&start=2000-01-01&end=2020-01-01", 400, []string{`"error": "start and end must be at most 10 years apart"`}},
+		{"GET", "/work-hours?calendar=gb&start=2300-01-01&end=2000-01-01", 400, []string{`"error": "end must not be before start"`}},
+		{"GET", "/work-hours?calendar=us&start=2026-12-22T12:00&end=2026-12-22T10:00", 400, []string{"end must not be before start"}},
+		{"GET", "/work-hours?calendar=us&start=x&end=2026-12-23", 400, []string{`invalid start \"x\"`}},
+		{"GET", "/work-hours?calendar=us&start=2026-12-22&end=x", 400, []string{`invalid end \"x\"`}},
+		{"GET", "/work-hours?calendar=us&start=2026-12-22&end=2026-12-23&tz=Nowhere", 400, []string{"unknown time zone"}},
+
+		{"GET", "/countries", 200, []string{`"code": "DE",`, `"alpha3": "DEU",`, `"code": "DE-BY",`, `"subdivisions": []`}},
+		{"HEAD", "/countries", 200, nil},
+		{"POST", "/countries", 405, []string{`"error": "method not allowed: POST"`}},
+		{"GET", "/nothing", 404, []string{`"error": "not found: /nothing"`}},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, srv.URL+test.path, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != test.status {
+			t.Errorf("%s %s: got status: %d; want: %d (%s)", test.method, test.path, resp.StatusCode, test.status, string(body))
+		}
+		if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("%s %s: got content type: %q", test.method, test.path, ct)
+		}
+		for _, s := range test.want {
+			if !strings.Contains(string(body), s) {
+				t.Errorf("%s %s: body %s doesn't contain %s", test.method, test.path, string(body), s)
+			}
+		}
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		want   string
+	}{
+		{badRequest("bad %s", "thing"), http.StatusBadRequest, `"error": "bad thing"`},
+		{fmt.Errorf("wrapped: %w", badRequest("bad")), http.StatusBadRequest, `"error": "bad"`},
+		{errors.New("boom"), http.StatusInternalServerError, `"error": "internal error"`},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		writeError(w, test.err)
+		if w.Code != test.status || !strings.Contains(w.Body.String(), test.want) {
+			t.Errorf("%v: got: %d %s; want: %d %s", test.err, w.Code, w.Body.String(), test.status, test.want)
+		}
+	}
+}
+
+func TestHandlerCalendar(t *testing.T) {
+	h := &Handler{Calendar: func(code string) *cal.BusinessCalendar {
+		if code != "office" {
+			return nil
+		}
+		c := cal.NewBusinessCalendar()
+		c.AddHoliday(&cal.Holiday{ID: "office/party", Name: "Party", Month: time.June, Day: 5, Func: cal.CalcDayOfMonth})
+		return c
+	}}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/workday?calendar=office&date=2026-06-05", nil))
+	var got Workday
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil || got.Workday || got.Holiday == nil || got.Holiday.ID != "office/party" {
+		t.Errorf("got: %+v, %v; want office holiday", got, err)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/workday?calendar=gb&date=2026-06-05", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("got status: %d; want: %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler().ServeHTTP(w, httptest.NewRequest("GET", "/openapi.json", nil))
+
+	var doc struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid document: %v", err)
+	}
+	if doc.OpenAPI == "" {
+		t.Error("missing openapi version")
+	}
+	for path := range doc.Paths {
+		w := httptest.NewRecorder()
+		NewHandler().ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code == http.StatusNotFound && strings.Contains(w.Body.String(), "not found:") {
+			t.Errorf("documented path %s isn't handled", path)
+		}
+	}
+	for _, path := range []string{"/countries", "/holidays", "/workday", "/workdays/add", "/work-hours", "/openapi.json"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("path %s isn't documented", path)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package calhttp
+
+// openAPI is the OpenAPI description of the Handler endpoints.
+const openAPI = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "cal",
+    "description": "Holiday and business day queries for registered calendars.",
+    "version": "2"
+  },
+  "paths": {
+    "/countries": {
+      "get": {
+        "summary": "List the registered countries and their subdivisions",
+        "operationId": "listCountries",
+        "responses": {
+          "200": {
+            "description": "Registered countries ordered by code",
+            "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Country"}}}}
+          }
+        }
+      }
+    },
+    "/holidays": {
+      "get": {
+        "summary": "List the holidays in a year or date range",
+        "description": "Reports each day that is a holiday or on which a holiday is observed. Use either year or both from and to.",
+        "operationId": "listHolidays",
+        "parameters": [
+          {"$ref": "#/components/parameters/calendar"},
+          {"name": "year", "in": "query", "schema": {"type": "integer"}, "example": 2027},
+          {"name": "from", "in": "query", "description": "First day of the range; not after to and at most 10 years before it", "schema": {"type": "string", "format": "date"}},
+          {"name": "to", "in": "query", "description": "Last day of the range", "schema": {"type": "string", "format": "date"}},
+          {"$ref": "#/components/parameters/lang"},
+          {"$ref": "#/components/parameters/tz"}
+        ],
+        "responses": {
+          "200": {
+            "description": "Holidays in ascending order",
+            "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Holiday"}}}}
+          },
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/workday": {
+      "get": {
+        "summary": "Report whether a day is a workday",
+        "operationId": "getWorkday",
+        "parameters": [
+          {"$ref": "#/components/parameters/calendar"},
+          {"name": "date", "in": "query", "required": true, "schema": {"type": "string", "format": "date"}, "example": "2026-12-24"},
+          {"$ref": "#/components/parameters/lang"},
+          {"$ref": "#/components/parameters/tz"}
+        ],
+        "responses": {
+          "200": {
+            "description": "Workday status of the day",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Workday"}}}
+          },
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/workdays/add": {
+      "get": {
+        "summary": "Add a number of workdays to a date",
+        "operationId": "addWorkdays",
+        "parameters": [
+          {"$ref": "#/components/parameters/calendar"},
+          {"name": "date", "in": "query", "required": true, "schema": {"type": "string", "format": "date"}, "example": "2026-12-22"},
+          {"name": "days", "in": "query", "required": true, "description": "Workdays to add; negative values subtract", "schema": {"type": "integer", "minimum": -10000, "maximum": 10000}, "example": 10},
+          {"$ref": "#/components/parameters/tz"}
+        ],
+        "responses": {
+          "200": {
+            "description": "The resulting date",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/AddWorkdays"}}}
+          },
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/work-hours": {
+      "get": {
+        "summary": "Report the working hours between two times",
+        "operationId": "getWorkHours",
+        "parameters": [
+          {"$ref": "#/components/parameters/calendar"},
+          {"name": "start", "in": "query", "required": true, "description": "Date, local date and time, or RFC 3339 time", "schema": {"type": "string"}, "example": "2026-12-22T10:00"},
+          {"name": "end", "in": "query", "required": true, "description": "Date, local date and time, or RFC 3339 time; not before start and at most 10 years after it", "schema": {"type": "string"}, "example": "2026-12-28T12:00"},
+          {"name": "hours", "in": "query", "description": "Working hours of each workday; an end before the start is an overnight shift", "schema": {"type": "string", "default": "09:00-17:00"}},
+          {"$ref": "#/components/parameters/tz"}
+        ],
+        "responses": {
+          "200": {
+            "description": "The working hours",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/WorkHours"}}}
+          },
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/openapi.json": {
+      "get": {
+        "summary": "This document",
+        "operationId": "getOpenAPI",
+        "responses": {"200": {"description": "OpenAPI description", "content": {"application/json": {}}}}
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "calendar": {
+        "name": "calendar", "in": "query", "required": true,
+        "description": "Country or subdivision codes such as gb or DE-BY. Codes separated by commas are combined so that a day is only a workday if it is a workday in all of them.",
+        "schema": {"type": "string"}, "example": "us,ecb"
+      },
+      "lang": {
+        "name": "lang", "in": "query",
+        "description": "Preferred languages for holiday names as comma-separated BCP 47 tags",
+        "schema": {"type": "string"}, "example": "fr,en"
+      },
+      "tz": {
+        "name": "tz", "in": "query",
+        "description": "IANA time zone of dates and times",
+        "schema": {"type": "string", "default": "UTC"}, "example": "Europe/Berlin"
+      }
+    },
+    "responses": {
+      "BadRequest": {"description": "Invalid parameters or limits exceeded", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "NotFound": {"description": "Unknown calendar", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Country": {
+        "type": "object",
+        "properties": {
+          "code": {"type": "string", "example": "DE"},
+          "alpha3": {"type": "string", "example": "DEU"},
+          "name": {"type": "string", "example": "Germany"},
+          "subdivisions": {"type": "array", "items": {"$ref": "#/components/schemas/Subdivision"}}
+        }
+      },
+      "Subdivision": {
+        "type": "object",
+        "properties": {
+          "code": {"type": "string", "example": "DE-BY"},
+          "name": {"type": "string", "example": "Bayern"}
+        }
+      },
+      "Holiday": {
+        "type": "object",
+        "properties": {
+          "date": {"type": "string", "format": "date"},
+          "weekday": {"type": "string", "example": "Monday"},
+          "id": {"type": "string", "example": "gb/boxing-day"},
+          "name": {"type": "string"},
+          "type": {"type": "string", "enum": ["unknown", "public", "bank", "religious", "other"]},
+          "actual": {"type": "boolean", "description": "The holiday occurs on the day"},
+          "observed": {"type": "boolean", "description": "The holiday is observed on the day"}
+        }
+      },
+      "Workday": {
+        "type": "object",
+        "properties": {
+          "date": {"type": "string", "format": "date"},
+          "workday": {"type": "boolean"},
+          "holiday": {"allOf": [{"$ref": "#/components/schemas/Holiday"}], "nullable": true}
+        }
+      },
+      "AddWorkdays": {
+        "type": "object",
+        "properties": {
+          "date": {"type": "string", "format": "date"},
+          "days": {"type": "integer"},
+          "result": {"type": "string", "format": "date"}
+        }
+      },
+      "WorkHours": {
+        "type": "object",
+        "properties": {
+          "start": {"type": "string", "format": "date-time"},
+          "end": {"type": "string", "format": "date-time"},
+          "duration": {"type": "string", "example": "26h0m0s"},
+          "hours": {"type": "number", "example": 26}
+        }
+      },
+      "Error": {
+        "type": "object",
+        "properties": {
+          "error": {"type": "string"}
+        }
+      }
+    }
+  }
+}
+`
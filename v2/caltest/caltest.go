@@ -0,0 +1,434 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Package caltest checks the holiday definitions of registered countries
+// against fixtures of expected dates.
+//
+// A fixture is a CSV file, usually testdata/holidays.csv in the country's
+// package, listing every occurrence of every holiday in the country's national
+// and regional holiday lists. It starts with notes naming the official list
+// the dates were checked against:
+//
+//	# Source: https://www.gov.uk/bank-holidays (England and Wales)
+//	calendar,id,date,observed
+//	GB,gb/new-year,2024-01-01,2024-01-01
+//	GB,gb/good-friday,2024-03-29,2024-03-29
+//
+// The calendar column is the country code for the national list and the
+// subdivision code for regional lists. Each calendar is checked for every year
+// from the first to the last year of its rows, so dates missing from the
+// fixture are reported as well as wrong ones.
+//
+// A fixture may cover only some of the calendars, such as a single state, by
+// listing their codes in a calendars note:
+//
+//	# Calendars: DE, DE-BY
+//
+// To create or refresh a fixture, run the tests with CALTEST_UPDATE=1 in the
+// environment, then check every date against the official list and add or
+// update the source note. Notes are kept when a fixture is refreshed.
+package caltest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal/v2"
+)
+
+// Years used when creating a new fixture.
+const (
+	FirstYear = 2015 // first year of new fixtures
+	LastYear  = 2030 // last year of new fixtures
+)
+
+// UpdateEnv is the environment variable that makes CheckFixture write the
+// calculated dates to the fixture instead of comparing them.
+const UpdateEnv = "CALTEST_UPDATE"
+
+// SourceNote is the prefix of the note naming the source of a fixture's dates.
+const SourceNote = "# Source:"
+
+// CalendarsNote is the prefix of the optional note listing the codes of the
+// calendars a fixture covers, separated by commas. A fixture without one
+// covers the national list and every subdivision's list.
+const CalendarsNote = "# Calendars:"
+
+const dateFormat = "2006-01-02"
+
+var header = []string{"calendar", "id", "date", "observed"}
+
+// Occurrence is a single occurrence of a holiday in a calendar.
+type Occurrence struct {
+	Calendar string    // country or subdivision code
+	ID       string    // holiday ID
+	Actual   time.Time // the date the holiday occurs
+	Observed time.Time // the date the holiday is observed
+}
+
+// String returns the occurrence in the fixture's CSV format.
+func (o Occurrence) String() string {
+	return strings.Join(o.record(), ",")
+}
+
+// record returns the fields of the occurrence in a fixture.
+func (o Occurrence) record() []string {
+	return []string{o.Calendar, o.ID, o.Actual.Format(dateFormat), o.Observed.Format(dateFormat)}
+}
+
+// ReadFixture reads the occurrences from a fixture, skipping its notes.
+func ReadFixture(r io.Reader) ([]Occurrence, error) {
+	_, occ, err := readFixture(r)
+	return occ, err
+}
+
+// readFixture reads the notes and occurrences from a fixture. Notes are the
+// lines starting with "#" before the header.
+func readFixture(r io.Reader) ([]string, []Occurrence, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var notes []string
+	text := string(data)
+	for strings.HasPrefix(text, "#") {
+		line := text
+		if i := strings.IndexByte(text, '\n'); i >= 0 {
+			line, text = text[:i], text[i+1:]
+		} else {
+			text = ""
+		}
+		notes = append(notes, strings.TrimRight(line, "\r"))
+	}
+
+	cr := csv.NewReader(strings.NewReader(text))
+	cr.FieldsPerRecord = len(header)
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rows) == 0 || strings.Join(rows[0], ",") != strings.Join(header, ",") {
+		return nil, nil, fmt.Errorf("missing header %q", strings.Join(header, ","))
+	}
+
+	occ := make([]Occurrence, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		line := len(notes) + i + 2
+		act, err := time.Parse(dateFormat, row[2])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid date %q", line, row[2])
+		}
+		obs, err := time.Parse(dateFormat, row[3])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid observed date %q", line, row[3])
+		}
+		occ = append(occ, Occurrence{Calendar: row[0], ID: row[1], Actual: act, Observed: obs})
+	}
+	return notes, occ, nil
+}
+
+// WriteFixture writes the occurrences as a fixture.
+func WriteFixture(w io.Writer, occ []Occurrence) error {
+	return writeFixture(w, nil, occ)
+}
+
+// writeFixture writes the notes, one per line, followed by the occurrences.
+func writeFixture(w io.Writer, notes []string, occ []Occurrence) error {
+	for _, n := range notes {
+		if _, err := io.WriteString(w, n+"\n"); err != nil {
+			return err
+		}
+	}
+	cw := csv.NewWriter(w)
+	cw.Write(header)
+	for _, o := range occ {
+		cw.Write(o.record())
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Calculate reports the occurrences of the holidays in the country's national
+// list and in each of its subdivisions' lists whose actual dates are in the
+// years from startYear to endYear inclusive.
+//
+// Occurrences are ordered by calendar, with the national list first, and then
+// by date and ID.
+func Calculate(c *cal.Country, startYear, endYear int) []Occurrence {
+	codes, lists := calendars(c)
+	return calculateAll(codes, lists, startYear, endYear)
+}
+
+// calculateAll reports the occurrences of the holidays in the lists of the
+// given calendars, in the order of the codes.
+func calculateAll(codes []string, lists map[string][]*cal.Holiday, startYear, endYear int) []Occurrence {
+	var r []Occurrence
+	for _, code := range codes {
+		r = append(r, calculate(code, lists[code], startYear, endYear)...)
+	}
+	return r
+}
+
+// calendars reports the codes of the country's calendars, with the national
+// list first, and the holiday list of each.
+func calendars(c *cal.Country) ([]string, map[string][]*cal.Holiday) {
+	codes := []string{c.Code}
+	lists := map[string][]*cal.Holiday{c.Code: c.Holidays}
+	for _, s := range c.Subdivisions {
+		codes = append(codes, s.Code)
+		lists[s.Code] = s.Holidays
+	}
+	return codes, lists
+}
+
+// calculate reports the occurrences of the holidays in a single list.
+func calculate(code string, holidays []*cal.Holiday, startYear, endYear int) []Occurrence {
+	var r []Occurrence
+	seen := make(map[Occurrence]bool)
+	// include the years either side for holidays moved across the new year
+	for year := startYear - 1; year <= endYear+1; year++ {
+		for _, h := range holidays {
+			act, obs := h.CalcIn(year, time.UTC)
+			o := Occurrence{Calendar: code, ID: h.ID, Actual: act, Observed: obs}
+			if act.IsZero() || act.Year() < startYear || act.Year() > endYear || seen[o] {
+				continue
+			}
+			seen[o] = true
+			r = append(r, o)
+		}
+	}
+	sort.SliceStable(r, func(i, j int) bool {
+		if !r[i].Actual.Equal(r[j].Actual) {
+			return r[i].Actual.Before(r[j].Actual)
+		}
+		return r[i].ID < r[j].ID
+	})
+	return r
+}
+
+// Diff compares the expected and calculated occurrences and reports a line
+// for each difference: "-" followed by an occurrence that is only expected, or
+// "+" followed by one that is only calculated. Lines are ordered by calendar
+// and date.
+//
+// If there are no differences, nil is returned.
+func Diff(want, got []Occurrence) []string {
+	count := make(map[Occurrence]int)
+	for _, o := range want {
+		count[o]++
+	}
+	for _, o := range got {
+		count[o]--
+	}
+
+	type line struct {
+		o    Occurrence
+		sign string
+	}
+	var lines []line
+	for o, n := range count {
+		for ; n > 0; n-- {
+			lines = append(lines, line{o, "-"})
+		}
+		for ; n < 0; n++ {
+			lines = append(lines, line{o, "+"})
+		}
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		a, b := lines[i], lines[j]
+		if a.o.Calendar != b.o.Calendar {
+			return a.o.Calendar < b.o.Calendar
+		}
+		if !a.o.Actual.Equal(b.o.Actual) {
+			return a.o.Actual.Before(b.o.Actual)
+		}
+		if a.o.ID != b.o.ID {
+			return a.o.ID < b.o.ID
+		}
+		return a.sign == "-"
+	})
+
+	var r []string
+	for _, l := range lines {
+		r = append(r, l.sign+" "+l.o.String())
+	}
+	return r
+}
+
+// CheckFixture compares the holidays of the registered country with the given
+// code against the fixture at path, reporting each calendar with differences
+// as a test error. A fixture without a source note is also reported.
+//
+// If the CALTEST_UPDATE environment variable is set, the fixture is written
+// instead, keeping its notes and covering the same years as before or
+// FirstYear to LastYear if the fixture doesn't exist.
+func CheckFixture(t testing.TB, code, path string) {
+	t.Helper()
+
+	c := cal.LookupCountry(code)
+	if c == nil {
+		t.Fatalf("unknown country %q", code)
+		return
+	}
+
+	notes, want, err := readFile(path)
+	if err != nil && !(os.IsNotExist(err) && os.Getenv(UpdateEnv) != "") {
+		t.Fatalf("%s: %v", path, err)
+		return
+	}
+
+	codes, lists := calendars(c)
+	if listed, ok := calendarsNote(notes); ok {
+		var covered []string
+		for _, code := range codes {
+			if listed[code] {
+				covered = append(covered, code)
+				delete(listed, code)
+			}
+		}
+		var unknown []string
+		for code := range listed {
+			unknown = append(unknown, code)
+		}
+		sort.Strings(unknown)
+		for _, code := range unknown {
+			t.Errorf("%s: unknown calendar %q in %q note", path, code, CalendarsNote)
+		}
+		codes = covered
+	}
+
+	if os.Getenv(UpdateEnv) != "" {
+		start, end := FirstYear, LastYear
+		if len(want) > 0 {
+			start, end = want[0].Actual.Year(), want[0].Actual.Year()
+			for _, y := range years(want) {
+				if y[0] < start {
+					start = y[0]
+				}
+				if y[1] > end {
+					end = y[1]
+				}
+			}
+		}
+		if err := writeFile(path, notes, calculateAll(codes, lists, start, end)); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		return
+	}
+
+	if !hasSource(notes) {
+		t.Errorf("%s: missing %q note naming the official source of the dates", path, SourceNote)
+	}
+
+	byCal := make(map[string][]Occurrence)
+	for _, o := range want {
+		byCal[o.Calendar] = append(byCal[o.Calendar], o)
+	}
+	covered := make(map[string]bool)
+	for _, code := range codes {
+		covered[code] = true
+	}
+	for code := range byCal {
+		if _, ok := lists[code]; !ok {
+			t.Errorf("%s: unknown calendar %q", path, code)
+		} else if !covered[code] {
+			t.Errorf("%s: calendar %q is not in the %q note", path, code, CalendarsNote)
+		}
+	}
+
+	ranges := years(want)
+	for _, code := range codes {
+		y, ok := ranges[code]
+		if !ok {
+			if len(lists[code]) > 0 {
+				t.Errorf("%s: no dates for %s", path, code)
+			}
+			continue
+		}
+		if diff := Diff(byCal[code], calculate(code, lists[code], y[0], y[1])); diff != nil {
+			t.Errorf("%s: %s %d-%d differs from fixture (- fixture, + calculated):\n%s",
+				path, code, y[0], y[1], strings.Join(diff, "\n"))
+		}
+	}
+}
+
+// years reports the first and last years of the occurrences of each calendar.
+func years(occ []Occurrence) map[string][2]int {
+	r := make(map[string][2]int)
+	for _, o := range occ {
+		y := o.Actual.Year()
+		cur, ok := r[o.Calendar]
+		if !ok {
+			cur = [2]int{y, y}
+		}
+		if y < cur[0] {
+			cur[0] = y
+		}
+		if y > cur[1] {
+			cur[1] = y
+		}
+		r[o.Calendar] = cur
+	}
+	return r
+}
+
+// hasSource reports whether the notes include a source note.
+func hasSource(notes []string) bool {
+	for _, n := range notes {
+		if strings.HasPrefix(n, SourceNote) && strings.TrimSpace(n[len(SourceNote):]) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// calendarsNote reports the calendar codes listed by the calendars note, if
+// there is one.
+func calendarsNote(notes []string) (map[string]bool, bool) {
+	for _, n := range notes {
+		if !strings.HasPrefix(n, CalendarsNote) {
+			continue
+		}
+		r := make(map[string]bool)
+		for _, code := range strings.Split(n[len(CalendarsNote):], ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				r[code] = true
+			}
+		}
+		return r, true
+	}
+	return nil, false
+}
+
+// readFile reads the fixture at path.
+func readFile(path string) ([]string, []Occurrence, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return readFixture(f)
+}
+
+// writeFile writes the fixture at path, creating its directory if needed.
+func writeFile(path string, notes []string, occ []Occurrence) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = writeFixture(f, notes, occ)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
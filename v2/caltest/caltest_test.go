@@ -0,0 +1,318 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package caltest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal/v2"
+)
+
+func d(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+var (
+	newYear = &cal.Holiday{
+		ID:       "zz/new-year",
+		Month:    time.January,
+		Day:      1,
+		Observed: []cal.AltDay{{Day: time.Saturday, Offset: -1}, {Day: time.Sunday, Offset: 1}},
+		Func:     cal.CalcDayOfMonth,
+	}
+	eve = &cal.Holiday{
+		ID:         "zz/eve",
+		Month:      time.December,
+		Day:        31,
+		CalcOffset: 1,
+		Func:       cal.CalcDayOfMonth,
+	}
+	fixed = &cal.Holiday{
+		ID: "zz/fixed",
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			return time.Date(2022, 6, 1, 0, 0, 0, 0, loc)
+		},
+	}
+	regional = &cal.Holiday{ID: "zz/regional", Month: time.June, Day: 1, Func: cal.CalcDayOfMonth}
+)
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "ZZ",
+		Name:     "Test",
+		Holidays: []*cal.Holiday{newYear, fixed},
+		Subdivisions: []*cal.Subdivision{
+			{Code: "ZZ-A", Holidays: []*cal.Holiday{newYear, regional, eve}},
+			{Code: "ZZ-B"},
+		},
+	})
+}
+
+const fixture = `calendar,id,date,observed
+ZZ,zz/new-year,2022-01-01,2021-12-31
+ZZ,zz/fixed,2022-06-01,2022-06-01
+ZZ,zz/new-year,2023-01-01,2023-01-02
+ZZ-A,zz/eve,2022-01-01,2022-01-01
+ZZ-A,zz/new-year,2022-01-01,2021-12-31
+ZZ-A,zz/regional,2022-06-01,2022-06-01
+ZZ-A,zz/eve,2023-01-01,2023-01-01
+ZZ-A,zz/new-year,2023-01-01,2023-01-02
+ZZ-A,zz/regional,2023-06-01,2023-06-01
+`
+
+// noted is the fixture with a source note.
+const noted = SourceNote + " test data\n" + fixture
+
+func TestReadWriteFixture(t *testing.T) {
+	occ, err := ReadFixture(strings.NewReader(fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occ) != 9 || occ[0] != (Occurrence{"ZZ", "zz/new-year", d(2022, 1, 1), d(2021, 12, 31)}) {
+		t.Errorf("got: %v", occ)
+	}
+	if got := occ[0].String(); got != "ZZ,zz/new-year,2022-01-01,2021-12-31" {
+		t.Errorf("got: %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteFixture(&buf, occ); err != nil || buf.String() != fixture {
+		t.Errorf("got: %q, %v; want: %q", buf.String(), err, fixture)
+	}
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "missing header"},
+		{"date,id,x,y\n", "missing header"},
+		{"calendar,id,date,observed\nZZ,zz/a,2022-01-01\n", "wrong number of fields"},
+		{"calendar,id,date,observed\nZZ,zz/a,1.1.2022,2022-01-01\n", `line 2: invalid date "1.1.2022"`},
+		{"calendar,id,date,observed\nZZ,zz/a,2022-01-01,x\n", `line 2: invalid observed date "x"`},
+		{"# a\r\n# b\ncalendar,id,date,observed\nZZ,zz/a,1.1.2022,2022-01-01\n", `line 4: invalid date "1.1.2022"`},
+		{"# Source: x", "missing header"},
+	}
+	for _, test := range tests {
+		if _, err := ReadFixture(strings.NewReader(test.in)); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%q: got: %v; want: %s", test.in, err, test.want)
+		}
+	}
+
+	notes, occ, err := readFixture(strings.NewReader("# Source: a\r\n# b\n" + fixture))
+	if err != nil || !reflect.DeepEqual(notes, []string{"# Source: a", "# b"}) || len(occ) != 9 {
+		t.Errorf("got: %q, %d occurrences, %v", notes, len(occ), err)
+	}
+	if _, err := ReadFixture(failReader{}); err == nil {
+		t.Errorf("got no error; want read error")
+	}
+}
+
+type failReader struct{}
+
+func (failReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteFixtureError(t *testing.T) {
+	if err := WriteFixture(failWriter{}, nil); err == nil {
+		t.Errorf("got no error; want write error")
+	}
+	if err := writeFixture(failWriter{}, []string{"# Source: x"}, nil); err == nil {
+		t.Errorf("got no error; want write error")
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	want, _ := ReadFixture(strings.NewReader(fixture))
+	if got := Calculate(cal.LookupCountry("ZZ"), 2022, 2023); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := Occurrence{"ZZ", "zz/a", d(2022, 1, 1), d(2022, 1, 1)}
+	aObs := Occurrence{"ZZ", "zz/a", d(2022, 1, 1), d(2022, 1, 3)}
+	b := Occurrence{"ZZ", "zz/b", d(2022, 1, 1), d(2022, 1, 1)}
+	c := Occurrence{"ZZ", "zz/c", d(2022, 6, 1), d(2022, 6, 1)}
+	e := Occurrence{"ZZ-A", "zz/a", d(2021, 1, 1), d(2021, 1, 1)}
+
+	tests := []struct {
+		want []Occurrence
+		got  []Occurrence
+		diff []string
+	}{
+		{nil, nil, nil},
+		{[]Occurrence{a, b}, []Occurrence{b, a}, nil},
+		{[]Occurrence{e, c, a, b}, []Occurrence{aObs, b, b}, []string{
+			"- ZZ,zz/a,2022-01-01,2022-01-01",
+			"+ ZZ,zz/a,2022-01-01,2022-01-03",
+			"+ ZZ,zz/b,2022-01-01,2022-01-01",
+			"- ZZ,zz/c,2022-06-01,2022-06-01",
+			"- ZZ-A,zz/a,2021-01-01,2021-01-01",
+		}},
+	}
+	for i, test := range tests {
+		if got := Diff(test.want, test.got); !reflect.DeepEqual(got, test.diff) {
+			t.Errorf("%d: got: %q; want: %q", i, got, test.diff)
+		}
+	}
+}
+
+// fakeT records the errors reported by CheckFixture.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, a ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, a...))
+}
+
+func (t *fakeT) Fatalf(format string, a ...interface{}) {
+	t.errors = append(t.errors, "fatal: "+fmt.Sprintf(format, a...))
+}
+
+func TestCheckFixture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "caltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Unsetenv(UpdateEnv)
+
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	good := write("good.csv", noted)
+	bad := write("bad.csv", strings.Replace(strings.Replace(noted, "2023-01-02", "2023-01-01", 1),
+		"ZZ-A,zz/regional,2023-06-01", "ZZ-X,zz/regional,2023-06-01", 1))
+	missing := write("missing.csv", SourceNote+" test data\ncalendar,id,date,observed\n"+
+		"ZZ,zz/new-year,2022-01-01,2021-12-31\nZZ,zz/fixed,2022-06-01,2022-06-01\n")
+	unnoted := write("unnoted.csv", fixture)
+	empty := write("empty.csv", SourceNote+"\n# checked by hand\n"+fixture)
+	dangling := filepath.Join(dir, "dangling.csv")
+	if err := os.Symlink(filepath.Join(dir, "nowhere", "x.csv"), dangling); err != nil {
+		t.Fatal(err)
+	}
+	invalid := write("invalid.csv", "date,id,x,y\n")
+	regionalOnly := SourceNote + " test data\n" + CalendarsNote + " ZZ-A\ncalendar,id,date,observed\n" +
+		fixture[strings.Index(fixture, "ZZ-A"):]
+	scoped := write("scoped.csv", regionalOnly)
+	unscoped := write("unscoped.csv", strings.Replace(regionalOnly, "ZZ-A\n", "ZZ-A, ZZ-X,\n", 1)+
+		"ZZ,zz/fixed,2022-06-01,2022-06-01\n")
+	scopedUpdate := write("scoped-update.csv", CalendarsNote+" ZZ-A\ncalendar,id,date,observed\n")
+	update := write("update.csv", "# Source: test data\ncalendar,id,date,observed\nZZ-A,zz/regional,2021-06-01,2021-06-01\n"+
+		"ZZ,zz/fixed,2022-06-01,2022-06-01\nZZ-A,zz/regional,2020-06-01,2020-06-01\n")
+
+	tests := []struct {
+		update bool
+		code   string
+		path   string
+		want   []string
+	}{
+		{false, "ZZ", good, nil},
+		{false, "XX", good, []string{`fatal: unknown country "XX"`}},
+		{false, "ZZ", filepath.Join(dir, "none.csv"), []string{"fatal: " + filepath.Join(dir, "none.csv") + ": open"}},
+		{false, "ZZ", invalid, []string{"fatal: " + invalid + ": missing header"}},
+		{false, "ZZ", bad, []string{
+			bad + `: unknown calendar "ZZ-X"`,
+			bad + ": ZZ 2022-2023 differs from fixture (- fixture, + calculated):\n" +
+				"- ZZ,zz/new-year,2023-01-01,2023-01-01\n+ ZZ,zz/new-year,2023-01-01,2023-01-02",
+			bad + ": ZZ-A 2022-2023 differs from fixture (- fixture, + calculated):\n" +
+				"+ ZZ-A,zz/regional,2023-06-01,2023-06-01",
+		}},
+		{false, "ZZ", missing, []string{missing + ": no dates for ZZ-A"}},
+		{false, "ZZ", scoped, nil},
+		{false, "ZZ", unscoped, []string{
+			unscoped + `: unknown calendar "ZZ-X" in "# Calendars:" note`,
+			unscoped + `: calendar "ZZ" is not in the "# Calendars:" note`,
+		}},
+		{false, "ZZ", unnoted, []string{unnoted + `: missing "# Source:" note`}},
+		{false, "ZZ", empty, []string{empty + `: missing "# Source:" note`}},
+		{true, "ZZ", filepath.Join(dir, "new", "new.csv"), nil},
+		{true, "ZZ", update, nil},
+		{true, "ZZ", scopedUpdate, nil},
+		{true, "ZZ", invalid, []string{"fatal: " + invalid + ": missing header"}},
+		{true, "ZZ", dangling, []string{"fatal: " + dangling + ": open"}},
+	}
+
+	for i, test := range tests {
+		os.Unsetenv(UpdateEnv)
+		if test.update {
+			os.Setenv(UpdateEnv, "1")
+		}
+		ft := &fakeT{}
+		CheckFixture(ft, test.code, test.path)
+		if len(ft.errors) != len(test.want) {
+			t.Errorf("%d: got: %q; want: %q", i, ft.errors, test.want)
+			continue
+		}
+		for j, want := range test.want {
+			if !strings.HasPrefix(ft.errors[j], want) {
+				t.Errorf("%d: got: %q; want: %q", i, ft.errors[j], want)
+			}
+		}
+	}
+	os.Unsetenv(UpdateEnv)
+
+	if err := writeFile(filepath.Join(good, "new", "new.csv"), nil, nil); err == nil {
+		t.Errorf("got no error; want mkdir error")
+	}
+
+	tests2 := []struct {
+		path  string
+		first int
+		last  int
+		notes []string
+	}{
+		{filepath.Join(dir, "new", "new.csv"), FirstYear, LastYear, nil},
+		{update, 2020, 2022, []string{"# Source: test data"}},
+	}
+	// a scoped fixture is only refreshed for its calendars
+	_, got, err := readFile(scopedUpdate)
+	if want := calculate("ZZ-A", cal.LookupCountry("ZZ").Subdivisions[0].Holidays, FirstYear, LastYear); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("%s: got: %v, %v; want: %v", scopedUpdate, got, err, want)
+	}
+
+	for _, test := range tests2 {
+		f, err := os.Open(test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		notes, got, err := readFixture(f)
+		f.Close()
+		if want := Calculate(cal.LookupCountry("ZZ"), test.first, test.last); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got: %v, %v; want: %v", test.path, got, err, want)
+		}
+		if !reflect.DeepEqual(notes, test.notes) {
+			t.Errorf("%s: got notes: %q; want: %q", test.path, notes, test.notes)
+		}
+		// a new fixture has no source note until one is added by hand
+		ft := &fakeT{}
+		CheckFixture(ft, "ZZ", test.path)
+		if (len(ft.errors) == 0) != (test.notes != nil) {
+			t.Errorf("%s: got: %q", test.path, ft.errors)
+		}
+	}
+}
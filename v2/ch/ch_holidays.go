@@ -12,68 +12,166 @@ import (
 
 var (
 	// Neujahr represents New Year's Day on 1-Jan
-	Neujahr = aa.NewYear.Clone(&cal.Holiday{Name: "Neujahrstag", Type: cal.ObservancePublic})
+	Neujahr = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "ch/neujahr",
+		Source: "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Name:   "Neujahrstag",
+		Names:  map[string]string{"de": "Neujahrstag", "fr": "Nouvel An", "it": "Capodanno", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Berchtoldstag represents an Alemannic holiday on 2-Jan
 	Berchtoldstag = &cal.Holiday{
-		Name:  "Berchtoldstag",
-		Month: time.January,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:           "ch/berchtoldstag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-BE", "CH-AG", "CH-TG", "CH-VD", "CH-JU"},
+		Name:         "Berchtoldstag",
+		Names:        map[string]string{"de": "Berchtoldstag", "fr": "Saint-Berchtold", "it": "San Bertoldo", "en": "Berchtold's Day"},
+		Month:        time.January,
+		Day:          2,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// HeiligeDreiKoenige represents Epiphany on 6-Jan
-	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{Name: "Heilige Drei Könige", Type: cal.ObservancePublic})
+	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{
+		ID:           "ch/heilige-drei-koenige",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-UR", "CH-SZ", "CH-GR", "CH-TI"},
+		Name:         "Heilige Drei Könige",
+		Names:        map[string]string{"de": "Heilige Drei Könige", "fr": "Épiphanie", "it": "Epifania", "en": "Epiphany"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Josefstag represents Feast of Saint Joseph on 19-Mar
 	Josefstag = &cal.Holiday{
-		Name:  "Josefstag",
-		Month: time.March,
-		Day:   19,
-		Func:  cal.CalcDayOfMonth,
+		ID:           "ch/josefstag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-LU", "CH-UR", "CH-SZ", "CH-NW", "CH-GR", "CH-TI", "CH-VS"},
+		Name:         "Josefstag",
+		Names:        map[string]string{"de": "Josefstag", "fr": "Saint-Joseph", "it": "San Giuseppe", "en": "St. Joseph's Day"},
+		Month:        time.March,
+		Day:          19,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// Karfreitag represents Good Friday on the Friday before Easter
-	Karfreitag = aa.GoodFriday.Clone(&cal.Holiday{Name: "Karfreitag", Type: cal.ObservancePublic})
+	Karfreitag = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:           "ch/karfreitag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-ZH", "CH-BE", "CH-LU", "CH-UR", "CH-SZ", "CH-OW", "CH-NW", "CH-GL", "CH-ZG", "CH-FR", "CH-SO", "CH-BS", "CH-BL", "CH-SH", "CH-AR", "CH-AI", "CH-SG", "CH-GR", "CH-AG", "CH-TG", "CH-VD", "CH-NE", "CH-GE", "CH-JU"},
+		Name:         "Karfreitag",
+		Names:        map[string]string{"de": "Karfreitag", "fr": "Vendredi saint", "it": "Venerdì santo", "en": "Good Friday"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Ostermontag represents Easter Monday on the day after Easter
-	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Ostermontag", Type: cal.ObservancePublic})
+	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:           "ch/ostermontag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-ZH", "CH-BE", "CH-UR", "CH-SZ", "CH-GL", "CH-ZG", "CH-BS", "CH-BL", "CH-SH", "CH-AR", "CH-AI", "CH-SG", "CH-GR", "CH-AG", "CH-TG", "CH-VD", "CH-TI", "CH-GE", "CH-JU"},
+		Name:         "Ostermontag",
+		Names:        map[string]string{"de": "Ostermontag", "fr": "Lundi de Pâques", "it": "Lunedì di Pasqua", "en": "Easter Monday"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// TagderArbeit represents Labour Day on 1-May
-	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{Name: "Tag der Arbeit", Type: cal.ObservancePublic})
+	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:           "ch/tag-der-arbeit",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-ZH", "CH-FR", "CH-SO", "CH-BS", "CH-BL", "CH-SH", "CH-TG", "CH-TI", "CH-NE", "CH-JU"},
+		Name:         "Tag der Arbeit",
+		Names:        map[string]string{"de": "Tag der Arbeit", "fr": "Fête du travail", "it": "Festa del lavoro", "en": "Labour Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Auffahrt represents Ascension Day on the 39th day after Easter
-	Auffahrt = aa.AscensionDay.Clone(&cal.Holiday{Name: "Auffahrt", Type: cal.ObservancePublic})
+	Auffahrt = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "ch/auffahrt",
+		Source: "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Name:   "Auffahrt",
+		Names:  map[string]string{"de": "Auffahrt", "fr": "Ascension", "it": "Ascensione", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pfingstmontag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Pfingstmontag", Type: cal.ObservancePublic})
+	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:           "ch/pfingstmontag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-ZH", "CH-BE", "CH-UR", "CH-SZ", "CH-GL", "CH-ZG", "CH-SO", "CH-BS", "CH-BL", "CH-SH", "CH-AR", "CH-AI", "CH-SG", "CH-GR", "CH-AG", "CH-TG", "CH-VD", "CH-TI", "CH-GE", "CH-JU"},
+		Name:         "Pfingstmontag",
+		Names:        map[string]string{"de": "Pfingstmontag", "fr": "Lundi de Pentecôte", "it": "Lunedì di Pentecoste", "en": "Whit Monday"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Fronleichnam represents Corpus Christi on the 60th day after Easter
-	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{Name: "Fronleichnam", Type: cal.ObservancePublic})
+	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:           "ch/fronleichnam",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-LU", "CH-UR", "CH-SZ", "CH-OW", "CH-NW", "CH-FR", "CH-SO", "CH-BL", "CH-AI", "CH-GR", "CH-AG", "CH-TI", "CH-VS", "CH-NE", "CH-GE", "CH-JU"},
+		Name:         "Fronleichnam",
+		Names:        map[string]string{"de": "Fronleichnam", "fr": "Fête-Dieu", "it": "Corpus Domini", "en": "Corpus Christi"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Bundesfeiertag represents the official national day of Switzerland on the 1-Aug
 	Bundesfeiertag = &cal.Holiday{
-		Name:  "Bundesfeiertag",
-		Month: time.August,
-		Day:   1,
-		Type:  cal.ObservancePublic,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ch/bundesfeiertag",
+		Source: "Bundesverfassung Art. 110 Abs. 3",
+		Name:   "Bundesfeiertag",
+		Names:  map[string]string{"de": "Bundesfeiertag", "fr": "Fête nationale", "it": "Festa nazionale", "en": "Swiss National Day"},
+		Month:  time.August,
+		Day:    1,
+		Type:   cal.ObservancePublic,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// MariaHimmelfahrt represents Assumption of Mary on 15-Aug
-	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Mariä Himmelfahrt", Type: cal.ObservancePublic})
+	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:           "ch/maria-himmelfahrt",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-LU", "CH-UR", "CH-SZ", "CH-OW", "CH-NW", "CH-FR", "CH-SO", "CH-BL", "CH-AI", "CH-GR", "CH-AG", "CH-TI", "CH-VS", "CH-JU"},
+		Name:         "Mariä Himmelfahrt",
+		Names:        map[string]string{"de": "Mariä Himmelfahrt", "fr": "Assomption", "it": "Assunzione", "en": "Assumption Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Allerheiligen represents All Saints' Day on 1-Nov
-	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Allerheiligen", Type: cal.ObservancePublic})
+	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:           "ch/allerheiligen",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-LU", "CH-UR", "CH-SZ", "CH-OW", "CH-NW", "CH-GL", "CH-ZG", "CH-FR", "CH-SO", "CH-AI", "CH-SG", "CH-GR", "CH-AG", "CH-TI", "CH-VS", "CH-JU"},
+		Name:         "Allerheiligen",
+		Names:        map[string]string{"de": "Allerheiligen", "fr": "Toussaint", "it": "Ognissanti", "en": "All Saints' Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	//MariaEmpfangnis represents Immaculate Conception
-	MariaEmpfangnis = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Mariä Empfängnis"})
+	MariaEmpfangnis = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:           "ch/maria-empfangnis",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-LU", "CH-UR", "CH-SZ", "CH-OW", "CH-NW", "CH-AI", "CH-GR", "CH-AG", "CH-TI", "CH-VS"},
+		Name:         "Mariä Empfängnis",
+		Names:        map[string]string{"de": "Mariä Empfängnis", "fr": "Immaculée Conception", "it": "Immacolata Concezione", "en": "Immaculate Conception"},
+	})
 
 	// Weihnachtstag represents Christmas Day on 25-Dec
-	Weihnachtstag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Weihnachtstag", Type: cal.ObservancePublic})
+	Weihnachtstag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "ch/weihnachtstag",
+		Source: "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Name:   "Weihnachtstag",
+		Names:  map[string]string{"de": "Weihnachtstag", "fr": "Noël", "it": "Natale", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ZweiterWeihnachtsfeiertag represents Boxing Day on 26-Dec
-	ZweiterWeihnachtsfeiertag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Zweiter Weihnachtsfeiertag", Type: cal.ObservancePublic})
+	ZweiterWeihnachtsfeiertag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:           "ch/zweiter-weihnachtsfeiertag",
+		Source:       "Arbeitsgesetz (SR 822.11) Art. 20a",
+		Subdivisions: []string{"CH-ZH", "CH-BE", "CH-LU", "CH-UR", "CH-SZ", "CH-GL", "CH-ZG", "CH-SO", "CH-BS", "CH-BL", "CH-SH", "CH-AR", "CH-AI", "CH-SG", "CH-GR", "CH-AG", "CH-TG", "CH-TI"},
+		Name:         "Zweiter Weihnachtsfeiertag",
+		Names:        map[string]string{"de": "Zweiter Weihnachtsfeiertag", "fr": "Saint-Étienne", "it": "Santo Stefano", "en": "St. Stephen's Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -211,7 +309,7 @@ var (
 		ZweiterWeihnachtsfeiertag,
 	}
 
-	// HolidaysZG provides a list of holidays in the Canton of ZG
+	// HolidaysZG provides a list of holidays in the Canton of Zug
 	HolidaysZG = []*cal.Holiday{
 		Neujahr,
 		Karfreitag,
@@ -464,4 +562,44 @@ var (
 		Allerheiligen,
 		Weihnachtstag,
 	}
+
+	// Subdivisions provides the holiday lists of each region by ISO 3166-2 code
+	Subdivisions = []*cal.Subdivision{
+		{Code: "CH-ZH", Name: "Zurich", Holidays: HolidaysZH},
+		{Code: "CH-BE", Name: "Bern", Holidays: HolidaysBE},
+		{Code: "CH-LU", Name: "Lucerne", Holidays: HolidaysLU},
+		{Code: "CH-UR", Name: "Uri", Holidays: HolidaysUR},
+		{Code: "CH-SZ", Name: "Schwyz", Holidays: HolidaysSZ},
+		{Code: "CH-OW", Name: "Obwalden", Holidays: HolidaysOW},
+		{Code: "CH-NW", Name: "Nidwalden", Holidays: HolidaysNW},
+		{Code: "CH-GL", Name: "Glarus", Holidays: HolidaysGL},
+		{Code: "CH-ZG", Name: "Zug", Holidays: HolidaysZG},
+		{Code: "CH-FR", Name: "Fribourg", Holidays: HolidaysFR},
+		{Code: "CH-SO", Name: "Solothurn", Holidays: HolidaysSO},
+		{Code: "CH-BS", Name: "Basel Stadt", Holidays: HolidaysBS},
+		{Code: "CH-BL", Name: "Basel Land", Holidays: HolidaysBL},
+		{Code: "CH-SH", Name: "Schaffhausen", Holidays: HolidaysSH},
+		{Code: "CH-AR", Name: "Appenzell Ausserrhoden", Holidays: HolidaysAR},
+		{Code: "CH-AI", Name: "Appenzell Innerrhoden", Holidays: HolidaysAI},
+		{Code: "CH-SG", Name: "St. Gallen", Holidays: HolidaysSG},
+		{Code: "CH-GR", Name: "Grisons", Holidays: HolidaysGR},
+		{Code: "CH-AG", Name: "Aargau", Holidays: HolidaysAG},
+		{Code: "CH-TG", Name: "Thurgau", Holidays: HolidaysTG},
+		{Code: "CH-VD", Name: "Vaud", Holidays: HolidaysVD},
+		{Code: "CH-TI", Name: "Ticino", Holidays: HolidaysTI},
+		{Code: "CH-VS", Name: "Valais", Holidays: HolidaysVS},
+		{Code: "CH-NE", Name: "Neuchâtel", Holidays: HolidaysNE},
+		{Code: "CH-GE", Name: "Geneva", Holidays: HolidaysGE},
+		{Code: "CH-JU", Name: "Jura", Holidays: HolidaysJU},
+	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:         "CH",
+		Alpha3:       "CHE",
+		Name:         "Switzerland",
+		Holidays:     Holidays,
+		Subdivisions: Subdivisions,
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/caltest"
 )
 
 func d(y, m, d int) time.Time {
@@ -175,3 +176,28 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		h    *cal.Holiday
+		tag  string
+		want string
+	}{
+		{Neujahr, "de-CH", "Neujahrstag"},
+		{Neujahr, "fr", "Nouvel An"},
+		{Neujahr, "it-CH", "Capodanno"},
+		{Neujahr, "en", "New Year's Day"},
+		{Bundesfeiertag, "fr-CH", "Fête nationale"},
+		{Bundesfeiertag, "rm", "Bundesfeiertag"},
+	}
+
+	for _, test := range tests {
+		if got := test.h.NameIn(test.tag); got != test.want {
+			t.Errorf("%s %s: got: %q; want: %q", test.h.Name, test.tag, got, test.want)
+		}
+	}
+}
+
+func TestFixture(t *testing.T) {
+	caltest.CheckFixture(t, "CH", "testdata/holidays.csv")
+}
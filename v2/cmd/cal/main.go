@@ -0,0 +1,389 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Command cal answers questions about holidays and business days using the
+// registered country calendars.
+//
+// Usage:
+//
+//	cal <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	countries                   list the registered countries
+//	holidays                    list the holidays in a year or date range
+//	is-holiday DATE             report whether a day is a holiday
+//	is-workday DATE             report whether a day is a workday
+//	add-workdays DATE N         add N workdays to a date (N may be negative)
+//	work-hours START END        report the working hours between two times
+//
+// Calendars are chosen with -c using a country or subdivision code such as
+// "gb" or "DE-BY". Codes separated by commas, such as "us,ecb", combine
+// calendars so that a day is only a workday if it is a workday in all of them.
+//
+// Output is a table by default; use -o csv or -o json for scripts. The
+// is-holiday and is-workday commands exit with status 0 if the answer is yes
+// and 1 if it is no. Invalid usage and other errors exit with status 2.
+//
+// Examples:
+//
+//	cal holidays -c DE-BY -year 2027
+//	cal is-workday -c gb 2026-12-24
+//	cal add-workdays -c us,ecb 2026-12-22 10
+//	cal work-hours -c us -hours 08:00-16:00 2026-12-22T10:00 2026-12-28T12:00
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rickar/cal/v2"
+	_ "github.com/rickar/cal/v2/all" // register all countries
+	"github.com/rickar/cal/v2/internal/calquery"
+)
+
+// Exit statuses
+const (
+	exitTrue  = 0 // success, or the answer to a question is yes
+	exitFalse = 1 // the answer to a question is no
+	exitError = 2 // invalid usage or other error
+)
+
+// exit is replaced in tests.
+var exit = os.Exit
+
+func main() {
+	exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// command describes a subcommand of cal.
+type command struct {
+	name string                                                // name used on the command line
+	args []string                                              // names of the positional arguments
+	desc string                                                // short description for the usage message
+	run  func(o *options, args []string) (*result, int, error) // performs the command
+}
+
+var commands = []*command{
+	{"countries", nil, "list the registered countries", runCountries},
+	{"holidays", nil, "list the holidays in a year or date range", runHolidays},
+	{"is-holiday", []string{"DATE"}, "report whether a day is a holiday", runIsHoliday},
+	{"is-workday", []string{"DATE"}, "report whether a day is a workday", runIsWorkday},
+	{"add-workdays", []string{"DATE", "N"}, "add N workdays to a date (N may be negative)", runAddWorkdays},
+	{"work-hours", []string{"START", "END"}, "report the working hours between two times", runWorkHours},
+}
+
+// options holds the flag values shared by all commands.
+type options struct {
+	codes  string    // calendar codes separated by commas
+	format string    // output format
+	lang   string    // preferred languages for holiday names
+	tz     string    // name of the time zone for dates and times
+	hours  workHours // working hours for each workday
+	year   int       // year of holidays to list
+	from   string    // first day of holidays to list
+	to     string    // last day of holidays to list
+
+	loc *time.Location // location loaded from tz
+}
+
+// run runs the command given by args and reports the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "-help" {
+		w := stdout
+		if len(args) == 0 {
+			w = stderr
+		}
+		usage(w)
+		if len(args) == 0 {
+			return exitError
+		}
+		return exitTrue
+	}
+
+	var cmd *command
+	for _, c := range commands {
+		if c.name == args[0] {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "cal: unknown command %q\n", args[0])
+		usage(stderr)
+		return exitError
+	}
+
+	o, pos, err := parseFlags(cmd, args[1:], stderr)
+	if err == flag.ErrHelp {
+		return exitTrue
+	}
+	if err != nil {
+		return exitError
+	}
+	if len(pos) != len(cmd.args) {
+		fmt.Fprintf(stderr, "usage: cal %s [flags] %s\n", cmd.name, strings.Join(cmd.args, " "))
+		return exitError
+	}
+
+	res, status, err := cmd.run(o, pos)
+	if err != nil {
+		fmt.Fprintf(stderr, "cal: %v\n", err)
+		return exitError
+	}
+	if err := res.write(stdout, o.format); err != nil {
+		fmt.Fprintf(stderr, "cal: %v\n", err)
+		return exitError
+	}
+	return status
+}
+
+// usage writes the list of commands to w.
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: cal <command> [flags] [arguments]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-28s %s\n", strings.TrimSpace(c.name+" "+strings.Join(c.args, " ")), c.desc)
+	}
+	fmt.Fprintf(w, "\nrun 'cal <command> -h' for the flags of a command\n")
+}
+
+// parseFlags parses the flags of a command and reports the positional
+// arguments. Flags may appear before, between or after the arguments.
+func parseFlags(cmd *command, args []string, stderr io.Writer) (*options, []string, error) {
+	o := &options{
+		hours: workHours{9 * time.Hour, 17 * time.Hour},
+		year:  time.Now().Year(),
+	}
+	fs := flag.NewFlagSet("cal "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&o.codes, "c", "", "calendar `codes`, e.g. gb, DE-BY or us,ecb")
+	fs.StringVar(&o.format, "o", "table", "output `format`: table, csv or json")
+	fs.StringVar(&o.lang, "lang", "", "preferred `languages` for holiday names, e.g. fr or de,en")
+	fs.StringVar(&o.tz, "tz", "Local", "time `zone` of dates and times")
+	fs.Var(&o.hours, "hours", "working `hours` of each workday")
+	fs.IntVar(&o.year, "year", o.year, "`year` of holidays to list")
+	fs.StringVar(&o.from, "from", "", "first `date` of holidays to list; overrides -year")
+	fs.StringVar(&o.to, "to", "", "last `date` of holidays to list; overrides -year")
+
+	var pos []string
+	for len(args) > 0 {
+		// negative numbers are arguments rather than flags
+		if _, err := strconv.Atoi(args[0]); err == nil {
+			pos = append(pos, args[0])
+			args = args[1:]
+			continue
+		}
+		if err := fs.Parse(args); err != nil {
+			return nil, nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		pos = append(pos, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	var err error
+	switch o.format {
+	case "table", "csv", "json":
+	default:
+		err = fmt.Errorf("unknown output format %q", o.format)
+	}
+	if err == nil {
+		o.loc, err = time.LoadLocation(o.tz)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "cal: %v\n", err)
+		return nil, nil, err
+	}
+	return o, pos, nil
+}
+
+// workHours is a flag value holding the start and end of a workday in the
+// form "09:00-17:00". An end before the start, as in "22:00-06:00", is an
+// overnight shift that ends on the next day.
+type workHours struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func (h *workHours) String() string {
+	return calquery.FormatHours(h.start, h.end)
+}
+
+func (h *workHours) Set(s string) error {
+	start, end, ok := calquery.ParseHours(s)
+	if !ok {
+		return errors.New("must be in the form 09:00-17:00 or 22:00-06:00")
+	}
+	h.start, h.end = start, end
+	return nil
+}
+
+// calendar creates the calendar selected by the -c and -hours flags.
+func (o *options) calendar() (cal.WorkCalendar, error) {
+	codes := calquery.SplitCodes(o.codes)
+	if len(codes) == 0 {
+		return nil, errors.New("no calendar given; use -c with a code such as gb or DE-BY")
+	}
+	return calquery.Calendar(codes, nil, o.hours.start, o.hours.end)
+}
+
+// name reports the name of a holiday in the languages selected by -lang.
+func (o *options) name(h *cal.Holiday) string {
+	if o.lang == "" {
+		return h.Name
+	}
+	return h.NameIn(strings.Split(o.lang, ",")...)
+}
+
+// parseDate parses a date such as 2026-12-24 in the selected time zone.
+func (o *options) parseDate(s string) (time.Time, error) {
+	t, err := calquery.ParseDate(s, o.loc)
+	if err != nil {
+		return t, fmt.Errorf("invalid date %q; use the form 2006-01-02", s)
+	}
+	return t, nil
+}
+
+// parseTime parses a date or a date and time such as 2026-12-24T09:30 in the
+// selected time zone, unless the time includes its own offset.
+func (o *options) parseTime(s string) (time.Time, error) {
+	t, ok := calquery.ParseTime(s, o.loc)
+	if !ok {
+		return t, fmt.Errorf("invalid time %q; use the form 2006-01-02T15:04", s)
+	}
+	return t, nil
+}
+
+func runCountries(o *options, args []string) (*result, int, error) {
+	r := &result{cols: []string{"code", "alpha3", "name", "subdivisions"}}
+	for _, c := range cal.Countries() {
+		r.rows = append(r.rows, []interface{}{c.Code, c.Alpha3, c.Name, len(c.Subdivisions)})
+	}
+	return r, exitTrue, nil
+}
+
+func runHolidays(o *options, args []string) (*result, int, error) {
+	c, err := o.calendar()
+	if err != nil {
+		return nil, exitError, err
+	}
+	start := time.Date(o.year, time.January, 1, 0, 0, 0, 0, o.loc)
+	end := time.Date(o.year, time.December, 31, 0, 0, 0, 0, o.loc)
+	if o.from != "" {
+		if start, err = o.parseDate(o.from); err != nil {
+			return nil, exitError, err
+		}
+	}
+	if o.to != "" {
+		if end, err = o.parseDate(o.to); err != nil {
+			return nil, exitError, err
+		}
+	}
+
+	r := &result{cols: []string{"date", "weekday", "id", "name", "type", "actual", "observed"}}
+	for _, h := range c.Occurrences(start, end) {
+		r.rows = append(r.rows, []interface{}{
+			h.Date.Format(calquery.DateLayout), h.Date.Weekday().String(), h.Holiday.ID, o.name(h.Holiday),
+			calquery.TypeName(h.Holiday.Type), h.Actual, h.Observed,
+		})
+	}
+	return r, exitTrue, nil
+}
+
+func runIsHoliday(o *options, args []string) (*result, int, error) {
+	c, date, err := o.calendarDate(args[0])
+	if err != nil {
+		return nil, exitError, err
+	}
+	act, obs, h := c.IsHoliday(date)
+	id, name := "", ""
+	if h != nil {
+		id, name = h.ID, o.name(h)
+	}
+	r := &result{
+		cols:   []string{"date", "holiday", "id", "name", "actual", "observed"},
+		rows:   [][]interface{}{{args[0], h != nil, id, name, act, obs}},
+		single: true,
+	}
+	return r, status(h != nil), nil
+}
+
+func runIsWorkday(o *options, args []string) (*result, int, error) {
+	c, date, err := o.calendarDate(args[0])
+	if err != nil {
+		return nil, exitError, err
+	}
+	ok := c.IsWorkday(date)
+	r := &result{
+		cols:   []string{"date", "workday"},
+		rows:   [][]interface{}{{args[0], ok}},
+		single: true,
+	}
+	return r, status(ok), nil
+}
+
+func runAddWorkdays(o *options, args []string) (*result, int, error) {
+	c, date, err := o.calendarDate(args[0])
+	if err != nil {
+		return nil, exitError, err
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		return nil, exitError, fmt.Errorf("invalid number of workdays %q", args[1])
+	}
+	r := &result{
+		cols:   []string{"date", "workdays", "result"},
+		rows:   [][]interface{}{{args[0], n, c.WorkdaysFrom(date, n).Format(calquery.DateLayout)}},
+		single: true,
+	}
+	return r, exitTrue, nil
+}
+
+func runWorkHours(o *options, args []string) (*result, int, error) {
+	c, err := o.calendar()
+	if err != nil {
+		return nil, exitError, err
+	}
+	start, err := o.parseTime(args[0])
+	if err != nil {
+		return nil, exitError, err
+	}
+	end, err := o.parseTime(args[1])
+	if err != nil {
+		return nil, exitError, err
+	}
+	d := c.WorkHoursInRange(start, end)
+	r := &result{
+		cols:   []string{"start", "end", "duration", "hours"},
+		rows:   [][]interface{}{{start.Format(calquery.TimeLayout), end.Format(calquery.TimeLayout), d.String(), d.Hours()}},
+		single: true,
+	}
+	return r, exitTrue, nil
+}
+
+// calendarDate creates the selected calendar and parses a date for it.
+func (o *options) calendarDate(s string) (cal.WorkCalendar, time.Time, error) {
+	c, err := o.calendar()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	date, err := o.parseDate(s)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return c, date, nil
+}
+
+// status reports the exit status for the answer to a question.
+func status(yes bool) int {
+	if yes {
+		return exitTrue
+	}
+	return exitFalse
+}
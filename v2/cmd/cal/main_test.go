@@ -0,0 +1,134 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args   []string
+		want   int
+		stdout []string
+		stderr []string
+	}{
+		{nil, exitError, nil, []string{"usage: cal <command>", "is-workday DATE"}},
+		{[]string{"help"}, exitTrue, []string{"usage: cal <command>"}, nil},
+		{[]string{"bogus"}, exitError, nil, []string{`unknown command "bogus"`, "usage:"}},
+		{[]string{"holidays", "-h"}, exitTrue, nil, []string{"-hours hours", "(default 09:00-17:00)"}},
+		{[]string{"holidays", "-x"}, exitError, nil, []string{"flag provided but not defined: -x"}},
+		{[]string{"holidays", "-o", "xml"}, exitError, nil, []string{`unknown output format "xml"`}},
+		{[]string{"holidays", "-tz", "Nowhere/Nothing"}, exitError, nil, []string{"Nowhere/Nothing"}},
+		{[]string{"holidays", "-hours", "09:00"}, exitError, nil, []string{"must be in the form 09:00-17:00"}},
+		{[]string{"holidays", "-hours", "9am-5pm"}, exitError, nil, []string{"must be in the form 09:00-17:00"}},
+		{[]string{"holidays", "-hours", "09:00-09:00"}, exitError, nil, []string{"must be in the form 09:00-17:00"}},
+		{[]string{"is-workday", "-c", "gb"}, exitError, nil, []string{"usage: cal is-workday [flags] DATE"}},
+		{[]string{"is-workday", "2026-12-24"}, exitError, nil, []string{"no calendar given"}},
+		{[]string{"is-workday", "-c", "gb+xx", "2026-12-24"}, exitError, nil, []string{`unknown calendar "xx"`}},
+		{[]string{"is-workday", "-c", "gb", "24.12.2026"}, exitError, nil, []string{`invalid date "24.12.2026"`}},
+
+		{[]string{"countries", "-o", "csv"}, exitTrue, []string{"code,alpha3,name,subdivisions\n", "DE,DEU,Germany,16\n"}, nil},
+
+		{[]string{"holidays", "-c", "DE-BY", "-year", "2027", "-tz", "UTC", "-lang", "en"}, exitTrue, []string{
+			"DATE        WEEKDAY    ID                             NAME              TYPE    ACTUAL  OBSERVED\n",
+			"2027-01-06  Wednesday  de/heilige-drei-koenige        Epiphany          public  true    true\n",
+		}, nil},
+		{[]string{"holidays", "-c", "gb", "-from", "2026-12-26", "-to", "2026-12-28", "-o", "csv"}, exitTrue, []string{
+			"date,weekday,id,name,type,actual,observed\n" +
+				"2026-12-26,Saturday,gb/boxing-day,Boxing Day,bank,true,false\n" +
+				"2026-12-28,Monday,gb/boxing-day,Boxing Day,bank,false,true\n",
+		}, nil},
+		{[]string{"holidays", "-c", "CH-GE", "-year", "2026", "-lang", "fr", "-o", "json"}, exitTrue, []string{
+			"[\n  {\n", `"id": "ch/neujahr",`, `"name": "Nouvel An",`, `"observed": true`,
+		}, nil},
+		{[]string{"holidays"}, exitError, nil, []string{"no calendar given"}},
+		{[]string{"holidays", "-c", "gb", "-from", "x"}, exitError, nil, []string{`invalid date "x"`}},
+		{[]string{"holidays", "-c", "gb", "-to", "x"}, exitError, nil, []string{`invalid date "x"`}},
+
+		{[]string{"is-holiday", "-c", "gb", "2026-12-28", "-o", "csv"}, exitTrue, []string{
+			"date,holiday,id,name,actual,observed\n2026-12-28,true,gb/boxing-day,Boxing Day,false,true\n",
+		}, nil},
+		{[]string{"is-holiday", "-c", "gb", "2026-12-29", "-o", "csv"}, exitFalse, []string{
+			"2026-12-29,false,,,false,false\n",
+		}, nil},
+		{[]string{"is-holiday", "2026-12-29"}, exitError, nil, []string{"no calendar given"}},
+
+		{[]string{"is-workday", "-c", "gb", "2026-12-24"}, exitTrue, []string{"DATE        WORKDAY\n2026-12-24  true\n"}, nil},
+		{[]string{"is-workday", "2026-12-25", "-c", "gb", "-o", "json"}, exitFalse, []string{"{\n  \"date\": \"2026-12-25\",\n  \"workday\": false\n}\n"}, nil},
+
+		{[]string{"add-workdays", "-c", "us,ecb", "2026-12-22", "10", "-o", "csv"}, exitTrue, []string{"2026-12-22,10,2027-01-07\n"}, nil},
+		{[]string{"add-workdays", "-c", "us", "2026-12-28", "-3", "-o", "csv"}, exitTrue, []string{"2026-12-28,-3,2026-12-22\n"}, nil},
+		{[]string{"add-workdays", "-c", "us", "2026-12-28", "x"}, exitError, nil, []string{`invalid number of workdays "x"`}},
+		{[]string{"add-workdays", "2026-12-28", "1"}, exitError, nil, []string{"no calendar given"}},
+
+		{[]string{"work-hours", "-c", "us", "-tz", "UTC", "-hours", "08:00-16:00", "2026-12-22T10:00", "2026-12-28T12:00"}, exitTrue, []string{
+			"2026-12-22T10:00:00Z  2026-12-28T12:00:00Z  26h0m0s   26\n",
+		}, nil},
+		{[]string{"work-hours", "-c", "us", "-tz", "UTC", "-hours", "00:00-24:00", "2026-12-22", "2026-12-23T06:30:00", "-o", "csv"}, exitTrue, []string{
+			"2026-12-22T00:00:00Z,2026-12-23T06:30:00Z,30h30m0s,30.5\n",
+		}, nil},
+		{[]string{"work-hours", "-c", "us", "-tz", "UTC", "2026-12-22T10:00:00+01:00", "2026-12-22T12:00:00Z", "-o", "csv"}, exitTrue, []string{
+			"2026-12-22T10:00:00+01:00,2026-12-22T12:00:00Z,3h0m0s,3\n",
+		}, nil},
+		{[]string{"work-hours", "-c", "us", "-tz", "UTC", "-hours", "22:00-06:00", "2026-12-23T00:00", "2026-12-28T00:00", "-o", "csv"}, exitTrue, []string{
+			"2026-12-23T00:00:00Z,2026-12-28T00:00:00Z,22h0m0s,22\n",
+		}, nil},
+		{[]string{"work-hours", "2026-12-22", "2026-12-23"}, exitError, nil, []string{"no calendar given"}},
+		{[]string{"work-hours", "-c", "us", "x", "2026-12-23"}, exitError, nil, []string{`invalid time "x"`}},
+		{[]string{"work-hours", "-c", "us", "2026-12-22", "x"}, exitError, nil, []string{`invalid time "x"`}},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		if got := run(test.args, &stdout, &stderr); got != test.want {
+			t.Errorf("%v: got status: %d; want: %d (%s)", test.args, got, test.want, stderr.String())
+		}
+		for _, s := range test.stdout {
+			if !strings.Contains(stdout.String(), s) {
+				t.Errorf("%v: stdout %q doesn't contain %q", test.args, stdout.String(), s)
+			}
+		}
+		for _, s := range test.stderr {
+			if !strings.Contains(stderr.String(), s) {
+				t.Errorf("%v: stderr %q doesn't contain %q", test.args, stderr.String(), s)
+			}
+		}
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestRunWriteError(t *testing.T) {
+	var stderr bytes.Buffer
+	if got := run([]string{"countries"}, failWriter{}, &stderr); got != exitError {
+		t.Errorf("got status: %d; want: %d", got, exitError)
+	}
+	if !strings.Contains(stderr.String(), "write failed") {
+		t.Errorf("got: %q; want write error", stderr.String())
+	}
+}
+
+func TestMainStatus(t *testing.T) {
+	args := os.Args
+	defer func() {
+		os.Args = args
+		exit = os.Exit
+	}()
+
+	status := -1
+	exit = func(code int) { status = code }
+	os.Args = []string{"cal", "help"}
+	main()
+	if status != exitTrue {
+		t.Errorf("got status: %d; want: %d", status, exitTrue)
+	}
+}
@@ -0,0 +1,98 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// result holds the output of a command as rows of named columns.
+type result struct {
+	cols   []string        // column names
+	rows   [][]interface{} // values of each row; strings, numbers or bools
+	single bool            // the result is one record rather than a list
+}
+
+// write writes the result to w in the given format: "table", "csv" or
+// "json". Unknown formats are written as tables.
+func (r *result) write(w io.Writer, format string) error {
+	switch format {
+	case "csv":
+		return r.writeCSV(w)
+	case "json":
+		return r.writeJSON(w)
+	default:
+		return r.writeTable(w)
+	}
+}
+
+// writeTable writes the result as aligned columns with a header line.
+func (r *result) writeTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(r.cols, "\t")))
+	for _, row := range r.rows {
+		fmt.Fprintln(tw, strings.Join(r.strings(row), "\t"))
+	}
+	return tw.Flush()
+}
+
+// writeCSV writes the result as CSV records with a header record.
+func (r *result) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(r.cols)
+	for _, row := range r.rows {
+		cw.Write(r.strings(row))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSON writes the result as a JSON object, or as an array of objects if
+// it's a list. Object keys are in column order.
+func (r *result) writeJSON(w io.Writer) error {
+	var b bytes.Buffer
+	if !r.single {
+		b.WriteByte('[')
+	}
+	for i, row := range r.rows {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('{')
+		for j, col := range r.cols {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			k, _ := json.Marshal(col)
+			v, _ := json.Marshal(row[j])
+			b.Write(k)
+			b.WriteByte(':')
+			b.Write(v)
+		}
+		b.WriteByte('}')
+	}
+	if !r.single {
+		b.WriteByte(']')
+	}
+
+	var out bytes.Buffer
+	json.Indent(&out, b.Bytes(), "", "  ")
+	out.WriteByte('\n')
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// strings formats the values of a row for text output.
+func (r *result) strings(row []interface{}) []string {
+	s := make([]string, len(row))
+	for i, v := range row {
+		s[i] = fmt.Sprint(v)
+	}
+	return s
+}
@@ -0,0 +1,75 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Command calserver serves holiday and business day queries over HTTP using
+// the registered country calendars.
+//
+// Usage:
+//
+//	calserver [-addr :8080]
+//
+// The endpoints are described by the OpenAPI document at /openapi.json.
+//
+// Examples:
+//
+//	curl 'localhost:8080/holidays?calendar=DE-BY&year=2027'
+//	curl 'localhost:8080/workday?calendar=gb&date=2026-12-24'
+//	curl 'localhost:8080/workdays/add?calendar=us,ecb&date=2026-12-22&days=10'
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	_ "github.com/rickar/cal/v2/all" // register all countries
+	"github.com/rickar/cal/v2/calhttp"
+)
+
+// exit and listen are replaced in tests.
+var (
+	exit   = os.Exit
+	listen = (*http.Server).ListenAndServe
+)
+
+// Server timeouts, so that slow or idle clients can't hold connections open.
+const (
+	readHeaderTimeout = 5 * time.Second  // time to read the request headers
+	readTimeout       = 10 * time.Second // time to read the whole request
+	writeTimeout      = 30 * time.Second // time to handle the request and write the response
+	idleTimeout       = 2 * time.Minute  // time a kept-alive connection may wait for the next request
+)
+
+func main() {
+	exit(run(os.Args[1:], os.Stderr))
+}
+
+// run starts the server and reports the exit status once it stops.
+func run(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("calserver", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	fmt.Fprintf(stderr, "calserver: listening on %s\n", *addr)
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           calhttp.NewHandler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+	if err := listen(srv); err != nil {
+		fmt.Fprintf(stderr, "calserver: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	defer func() { listen = (*http.Server).ListenAndServe }()
+
+	tests := []struct {
+		args   []string
+		listen error
+		want   int
+		addr   string
+		stderr string
+	}{
+		{nil, nil, 0, ":8080", "listening on :8080"},
+		{[]string{"-addr", "localhost:9000"}, nil, 0, "localhost:9000", "listening on localhost:9000"},
+		{nil, errors.New("address in use"), 1, ":8080", "calserver: address in use"},
+		{[]string{"-h"}, nil, 0, "", "-addr string"},
+		{[]string{"-x"}, nil, 2, "", "flag provided but not defined: -x"},
+	}
+
+	for _, test := range tests {
+		var gotAddr string
+		var gotCode int
+		lerr := test.listen
+		listen = func(srv *http.Server) error {
+			gotAddr = srv.Addr
+			if srv.ReadHeaderTimeout == 0 || srv.ReadTimeout == 0 || srv.WriteTimeout == 0 || srv.IdleTimeout == 0 {
+				t.Errorf("missing timeouts: %+v", srv)
+			}
+			w := httptest.NewRecorder()
+			srv.Handler.ServeHTTP(w, httptest.NewRequest("GET", "/workday?calendar=gb&date=2026-12-25", nil))
+			gotCode = w.Code
+			return lerr
+		}
+
+		var stderr bytes.Buffer
+		if got := run(test.args, &stderr); got != test.want {
+			t.Errorf("%v: got status: %d; want: %d", test.args, got, test.want)
+		}
+		if gotAddr != test.addr {
+			t.Errorf("%v: got addr: %q; want: %q", test.args, gotAddr, test.addr)
+		}
+		if test.addr != "" && gotCode != http.StatusOK {
+			t.Errorf("%v: got handler status: %d; want: %d", test.args, gotCode, http.StatusOK)
+		}
+		if !strings.Contains(stderr.String(), test.stderr) {
+			t.Errorf("%v: stderr %q doesn't contain %q", test.args, stderr.String(), test.stderr)
+		}
+	}
+}
+
+func TestMainStatus(t *testing.T) {
+	args := os.Args
+	defer func() {
+		os.Args = args
+		exit = os.Exit
+		listen = (*http.Server).ListenAndServe
+	}()
+
+	status := -1
+	exit = func(code int) { status = code }
+	listen = func(*http.Server) error { return nil }
+	os.Args = []string{"calserver", "-addr", ":0"}
+	main()
+	if status != 0 {
+		t.Errorf("got status: %d; want: 0", status)
+	}
+}
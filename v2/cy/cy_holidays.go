@@ -12,14 +12,26 @@ import (
 
 var (
 	// Protochronia represents New Year's Day on 1-Jan
-	Protochronia = aa.NewYear.Clone(&cal.Holiday{Name: "Πρωτοχρονιά", Type: cal.ObservancePublic})
+	Protochronia = aa.NewYear.Clone(&cal.Holiday{
+		ID:    "cy/protochronia",
+		Name:  "Πρωτοχρονιά",
+		Names: map[string]string{"el": "Πρωτοχρονιά", "en": "New Year's Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Theofania represents Epiphany on 6-Jan
-	Theofania = aa.Epiphany.Clone(&cal.Holiday{Name: "Θεοφάνεια", Type: cal.ObservancePublic})
+	Theofania = aa.Epiphany.Clone(&cal.Holiday{
+		ID:    "cy/theofania",
+		Name:  "Θεοφάνεια",
+		Names: map[string]string{"el": "Θεοφάνεια", "en": "Epiphany"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// KatharaDeftera represents Green Monday (movable, 48 days before Orthodox Easter Sunday)
 	KatharaDeftera = &cal.Holiday{
+		ID:     "cy/kathara-deftera",
 		Name:   "Καθαρά Δευτέρα",
+		Names:  map[string]string{"el": "Καθαρά Δευτέρα", "en": "Green Monday"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Julian: true,
@@ -28,7 +40,9 @@ var (
 
 	// EllinikiEpanastasi represents Greek Independence Day on 25-Mar
 	EllinikiEpanastasi = &cal.Holiday{
+		ID:    "cy/elliniki-epanastasi",
 		Name:  "Ελληνική Επανάσταση",
+		Names: map[string]string{"el": "Ελληνική Επανάσταση", "en": "Greek Independence Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.March,
 		Day:   25,
@@ -37,7 +51,9 @@ var (
 
 	// EthnikiEpetios represents National Day (EOKA Day) on 1-Apr
 	EthnikiEpetios = &cal.Holiday{
+		ID:    "cy/ethniki-epetios",
 		Name:  "Εθνική Επέτειος",
+		Names: map[string]string{"el": "Εθνική Επέτειος", "en": "Cyprus National Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.April,
 		Day:   1,
@@ -45,31 +61,42 @@ var (
 	}
 
 	// ErgatikoiProtomagia represents Labour Day on 1-May
-	ErgatikoiProtomagia = aa.WorkersDay.Clone(&cal.Holiday{Name: "Εργατική Πρωτομαγιά", Type: cal.ObservancePublic})
+	ErgatikoiProtomagia = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:    "cy/ergatikoi-protomagia",
+		Name:  "Εργατική Πρωτομαγιά",
+		Names: map[string]string{"el": "Εργατική Πρωτομαγιά", "en": "Labour Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// MegaliParaskevi represents Orthodox Good Friday (movable Julian calendar-based)
 	MegaliParaskevi = &cal.Holiday{
-		Name: "Μεγάλη Παρασκευή",
-		Type: cal.ObservancePublic,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:    "cy/megali-paraskevi",
+		Name:  "Μεγάλη Παρασκευή",
+		Names: map[string]string{"el": "Μεγάλη Παρασκευή", "en": "Good Friday"},
+		Type:  cal.ObservancePublic,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			// Orthodox Good Friday is 2 days before Orthodox Easter Sunday
-			return cal.CalcEasterOffset(&cal.Holiday{Offset: -2, Julian: true}, year)
+			return cal.CalcEasterOffset(&cal.Holiday{Offset: -2, Julian: true}, year, loc)
 		},
 	}
 
 	// DeuteraTouPascha represents Orthodox Easter Monday (Julian calendar-based)
 	DeuteraTouPascha = &cal.Holiday{
-		Name: "Δευτέρα του Πάσχα",
-		Type: cal.ObservancePublic,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:    "cy/deutera-tou-pascha",
+		Name:  "Δευτέρα του Πάσχα",
+		Names: map[string]string{"el": "Δευτέρα του Πάσχα", "en": "Easter Monday"},
+		Type:  cal.ObservancePublic,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			// Orthodox Easter Monday is 1 day after Orthodox Easter Sunday
-			return cal.CalcEasterOffset(&cal.Holiday{Offset: 1, Julian: true}, year)
+			return cal.CalcEasterOffset(&cal.Holiday{Offset: 1, Julian: true}, year, loc)
 		},
 	}
 
 	// AgiouPnevmatos represents Orthodox Whit Monday (movable, 50 days after Orthodox Easter Sunday)
 	AgiouPnevmatos = &cal.Holiday{
+		ID:     "cy/agiou-pnevmatos",
 		Name:   "Αγίου Πνεύματος",
+		Names:  map[string]string{"el": "Αγίου Πνεύματος", "en": "Whit Monday"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Julian: true,
@@ -77,11 +104,18 @@ var (
 	}
 
 	// KoimisiTisTheotokou represents Assumption Day on 15-Aug
-	KoimisiTisTheotokou = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Κοίμηση της Θεοτόκου", Type: cal.ObservancePublic})
+	KoimisiTisTheotokou = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:    "cy/koimisi-tis-theotokou",
+		Name:  "Κοίμηση της Θεοτόκου",
+		Names: map[string]string{"el": "Κοίμηση της Θεοτόκου", "en": "Assumption Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Anexartisia represents Cyprus Independence Day on 1-Oct
 	Anexartisia = &cal.Holiday{
+		ID:    "cy/anexartisia",
 		Name:  "Ανεξαρτησία της Κύπρου",
+		Names: map[string]string{"el": "Ανεξαρτησία της Κύπρου", "en": "Cyprus Independence Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.October,
 		Day:   1,
@@ -90,7 +124,9 @@ var (
 
 	// EpeteiosTouOchi represents Ochi Day on 28-Oct
 	EpeteiosTouOchi = &cal.Holiday{
+		ID:    "cy/epeteios-tou-ochi",
 		Name:  "Επέτειος του Όχι",
+		Names: map[string]string{"el": "Επέτειος του Όχι", "en": "Ochi Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.October,
 		Day:   28,
@@ -98,10 +134,20 @@ var (
 	}
 
 	// Christougenna represents Christmas Day on 25-Dec
-	Christougenna = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Χριστούγεννα", Type: cal.ObservancePublic})
+	Christougenna = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:    "cy/christougenna",
+		Name:  "Χριστούγεννα",
+		Names: map[string]string{"el": "Χριστούγεννα", "en": "Christmas Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// DeyteriMeraTonChristougennon represents Boxing Day (Second Day of Christmas) on 26-Dec
-	DeyteriMeraTonChristougennon = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Δεύτερη Μέρα των Χριστουγέννων", Type: cal.ObservancePublic})
+	DeyteriMeraTonChristougennon = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:    "cy/deyteri-mera-ton-christougennon",
+		Name:  "Δεύτερη Μέρα των Χριστουγέννων",
+		Names: map[string]string{"el": "Δεύτερη Μέρα των Χριστουγέννων", "en": "Boxing Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -121,3 +167,12 @@ var (
 		DeyteriMeraTonChristougennon,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "CY",
+		Alpha3:   "CYP",
+		Name:     "Cyprus",
+		Holidays: Holidays,
+	})
+}
@@ -12,85 +12,142 @@ import (
 
 var (
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Nový rok", Type: cal.ObservancePublic})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "cz/new-year",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Nový rok",
+		Names:  map[string]string{"cs": "Nový rok", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Velký pátek", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "cz/good-friday",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Velký pátek",
+		Names:  map[string]string{"cs": "Velký pátek", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Velikonoční pondělí", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "cz/easter-monday",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Velikonoční pondělí",
+		Names:  map[string]string{"cs": "Velikonoční pondělí", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labour Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Svátek práce", Type: cal.ObservancePublic})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "cz/labour-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Svátek práce",
+		Names:  map[string]string{"cs": "Svátek práce", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LiberationDay represents Liberation Day on 8-May
 	LiberationDay = &cal.Holiday{
-		Name:  "Den osvobození",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/liberation-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den osvobození",
+		Names:  map[string]string{"cs": "Den osvobození", "en": "Liberation Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SaintsCyrilMethodius represents Saints Cyril and Methodius Day on 5-Jul
 	SaintsCyrilMethodius = &cal.Holiday{
-		Name:  "Den slovanských věrozvěstů Cyrila a Metoděje",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   5,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/saints-cyril-methodius",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den slovanských věrozvěstů Cyrila a Metoděje",
+		Names:  map[string]string{"cs": "Den slovanských věrozvěstů Cyrila a Metoděje", "en": "Saints Cyril and Methodius Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    5,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// JanHusDay represents Jan Hus Day on 6-Jul
 	JanHusDay = &cal.Holiday{
-		Name:  "Den upálení mistra Jana Husa",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   6,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/jan-hus-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den upálení mistra Jana Husa",
+		Names:  map[string]string{"cs": "Den upálení mistra Jana Husa", "en": "Jan Hus Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    6,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SaintWenceslasDay represents Saint Wenceslas Day on 28-Sep
 	SaintWenceslasDay = &cal.Holiday{
-		Name:  "Den české státnosti",
-		Type:  cal.ObservancePublic,
-		Month: time.September,
-		Day:   28,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/saint-wenceslas-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den české státnosti",
+		Names:  map[string]string{"cs": "Den české státnosti", "en": "Czech Statehood Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.September,
+		Day:    28,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// IndependenceDay represents Independent Czechoslovak State Day on 28-Oct
 	IndependenceDay = &cal.Holiday{
-		Name:  "Den vzniku samostatného československého státu",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   28,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/independence-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den vzniku samostatného československého státu",
+		Names:  map[string]string{"cs": "Den vzniku samostatného československého státu", "en": "Independent Czechoslovak State Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    28,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// FreedomDay represents Struggle for Freedom and Democracy Day on 17-Nov
 	FreedomDay = &cal.Holiday{
-		Name:  "Den boje za svobodu a demokracii",
-		Type:  cal.ObservancePublic,
-		Month: time.November,
-		Day:   17,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/freedom-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Den boje za svobodu a demokracii",
+		Names:  map[string]string{"cs": "Den boje za svobodu a demokracii", "en": "Struggle for Freedom and Democracy Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.November,
+		Day:    17,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasEve represents Christmas Eve 24-Dec
 	ChristmasEve = &cal.Holiday{
-		Name:  "Štědrý den",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "cz/christmas-eve",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "Štědrý den",
+		Names:  map[string]string{"cs": "Štědrý den", "en": "Christmas Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "1. svátek vánoční", Type: cal.ObservancePublic})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "cz/christmas-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "1. svátek vánoční",
+		Names:  map[string]string{"cs": "1. svátek vánoční", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SaintStephensDay represents Saints Stephen's Day on 26-Dec
-	SaintStephensDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "2. svátek vánoční", Type: cal.ObservancePublic})
+	SaintStephensDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "cz/saint-stephens-day",
+		Source: "Zákon č. 245/2000 Sb.",
+		Name:   "2. svátek vánoční",
+		Names:  map[string]string{"cs": "2. svátek vánoční", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -109,3 +166,12 @@ var (
 		SaintStephensDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "CZ",
+		Alpha3:   "CZE",
+		Name:     "Czech Republic",
+		Holidays: Holidays,
+	})
+}
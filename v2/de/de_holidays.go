@@ -12,96 +12,186 @@ import (
 
 var (
 	// Neujahr represents New Year's Day on 1-Jan
-	Neujahr = aa.NewYear.Clone(&cal.Holiday{Name: "Neujahrstag", Type: cal.ObservancePublic})
+	Neujahr = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "de/neujahr",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Neujahrstag",
+		Names:  map[string]string{"de": "Neujahrstag", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// HeiligeDreiKoenige represents Epiphany on 6-Jan
-	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{Name: "Heilige Drei Könige", Type: cal.ObservancePublic})
+	HeiligeDreiKoenige = aa.Epiphany.Clone(&cal.Holiday{
+		ID:           "de/heilige-drei-koenige",
+		Source:       "Feiertagsgesetze der Länder",
+		Subdivisions: []string{"DE-BW", "DE-BY", "DE-ST"},
+		Name:         "Heilige Drei Könige",
+		Names:        map[string]string{"de": "Heilige Drei Könige", "en": "Epiphany"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Frauentag represents Women's Day on 8-Mar
 	Frauentag = &cal.Holiday{
-		Name:  "Frauentag",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:           "de/frauentag",
+		Source:       "Berliner Gesetz über die Sonn- und Feiertage",
+		Subdivisions: []string{"DE-BE"},
+		Name:         "Frauentag",
+		Names:        map[string]string{"de": "Frauentag", "en": "International Women's Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		Day:          8,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// Karfreitag represents Good Friday on the Friday before Easter
-	Karfreitag = aa.GoodFriday.Clone(&cal.Holiday{Name: "Karfreitag", Type: cal.ObservancePublic})
+	Karfreitag = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "de/karfreitag",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Karfreitag",
+		Names:  map[string]string{"de": "Karfreitag", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Ostermontag represents Easter Monday on the day after Easter
-	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Ostermontag", Type: cal.ObservancePublic})
+	Ostermontag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "de/ostermontag",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Ostermontag",
+		Names:  map[string]string{"de": "Ostermontag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TagderArbeit represents Labour Day on 1-May
-	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{Name: "Tag der Arbeit", Type: cal.ObservancePublic})
+	TagderArbeit = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "de/tag-der-arbeit",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Tag der Arbeit",
+		Names:  map[string]string{"de": "Tag der Arbeit", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristiHimmelfahrt represents Ascension Day on the 39th day after Easter
-	ChristiHimmelfahrt = aa.AscensionDay.Clone(&cal.Holiday{Name: "Christi Himmelfahrt", Type: cal.ObservancePublic})
+	ChristiHimmelfahrt = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "de/christi-himmelfahrt",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Christi Himmelfahrt",
+		Names:  map[string]string{"de": "Christi Himmelfahrt", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pfingstmontag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Pfingstmontag", Type: cal.ObservancePublic})
+	Pfingstmontag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "de/pfingstmontag",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Pfingstmontag",
+		Names:  map[string]string{"de": "Pfingstmontag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Fronleichnam represents Corpus Christi on the 60th day after Easter
-	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{Name: "Fronleichnam", Type: cal.ObservancePublic})
-
-	// Friedensfest represents the Augsburger Hohes Friedensfest on 8-Aug
+	Fronleichnam = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:           "de/fronleichnam",
+		Source:       "Feiertagsgesetze der Länder",
+		Subdivisions: []string{"DE-BW", "DE-BY", "DE-HE", "DE-NW", "DE-RP", "DE-SL"},
+		Name:         "Fronleichnam",
+		Names:        map[string]string{"de": "Fronleichnam", "en": "Corpus Christi"},
+		Type:         cal.ObservancePublic,
+	})
+
+	// Friedensfest represents the Augsburger Hohes Friedensfest on 8-Aug, a
+	// holiday only in the city of Augsburg
 	Friedensfest = &cal.Holiday{
-		Name:      "Friedensfest",
-		Type:      cal.ObservancePublic,
-		Month:     time.August,
-		Day:       8,
-		Func:      cal.CalcDayOfMonth,
-		StartYear: 1950,
+		ID:           "de/friedensfest",
+		Source:       "Bayerisches Feiertagsgesetz Art. 1 Abs. 2",
+		Subdivisions: []string{"DE-BY"},
+		Name:         "Friedensfest",
+		Names:        map[string]string{"de": "Friedensfest", "en": "Peace Festival"},
+		Type:         cal.ObservancePublic,
+		Month:        time.August,
+		Day:          8,
+		Func:         cal.CalcDayOfMonth,
+		StartYear:    1950,
 	}
 
 	// MariaHimmelfahrt represents Assumption of Mary on 15-Aug
-	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Mariä Himmelfahrt", Type: cal.ObservancePublic})
+	MariaHimmelfahrt = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:           "de/maria-himmelfahrt",
+		Source:       "Saarländisches Feiertagsgesetz",
+		Subdivisions: []string{"DE-SL"},
+		Name:         "Mariä Himmelfahrt",
+		Names:        map[string]string{"de": "Mariä Himmelfahrt", "en": "Assumption Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// Weltkindertag represents World Children's Day on 20-Sep
 	Weltkindertag = &cal.Holiday{
-		Name:      "Weltkindertag",
-		Type:      cal.ObservancePublic,
-		Month:     time.September,
-		Day:       20,
-		Func:      cal.CalcDayOfMonth,
-		StartYear: 2019,
+		ID:           "de/weltkindertag",
+		Source:       "Thüringer Feiertagsgesetz",
+		Subdivisions: []string{"DE-TH"},
+		Name:         "Weltkindertag",
+		Names:        map[string]string{"de": "Weltkindertag", "en": "World Children's Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.September,
+		Day:          20,
+		Func:         cal.CalcDayOfMonth,
+		StartYear:    2019,
 	}
 
 	// DeutschenEinheit represents German Unity Day on 3-Oct
 	DeutschenEinheit = &cal.Holiday{
-		Name:  "Tag der Deutschen Einheit",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   3,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "de/deutschen-einheit",
+		Source: "Einigungsvertrag Art. 2 Abs. 2",
+		Name:   "Tag der Deutschen Einheit",
+		Names:  map[string]string{"de": "Tag der Deutschen Einheit", "en": "German Unity Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    3,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Reformationstag represents Reformation Day on 31-Oct
 	Reformationstag = &cal.Holiday{
-		Name:  "Reformationstag",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:           "de/reformationstag",
+		Source:       "Feiertagsgesetze der Länder",
+		Subdivisions: []string{"DE-BB", "DE-HB", "DE-HH", "DE-MV", "DE-NI", "DE-SN", "DE-ST", "DE-SH", "DE-TH"},
+		Name:         "Reformationstag",
+		Names:        map[string]string{"de": "Reformationstag", "en": "Reformation Day"},
+		Type:         cal.ObservancePublic,
+		Month:        time.October,
+		Day:          31,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// Allerheiligen represents All Saints' Day on 1-Nov
-	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Allerheiligen", Type: cal.ObservancePublic})
+	Allerheiligen = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:           "de/allerheiligen",
+		Source:       "Feiertagsgesetze der Länder",
+		Subdivisions: []string{"DE-BW", "DE-BY", "DE-NW", "DE-RP", "DE-SL"},
+		Name:         "Allerheiligen",
+		Names:        map[string]string{"de": "Allerheiligen", "en": "All Saints' Day"},
+		Type:         cal.ObservancePublic,
+	})
 
 	// BussUndBettag represents Repentance and Prayer Day on the first Wednesday between 16-22 Nov
 	BussUndBettag = &cal.Holiday{
-		Name:    "Buß- und Bettag",
-		Type:    cal.ObservancePublic,
-		Month:   time.November,
-		Day:     16,
-		Weekday: time.Wednesday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayFrom,
+		ID:           "de/buss-und-bettag",
+		Source:       "Sächsisches Sonn- und Feiertagsgesetz",
+		Subdivisions: []string{"DE-SN"},
+		Name:         "Buß- und Bettag",
+		Names:        map[string]string{"de": "Buß- und Bettag", "en": "Day of Repentance and Prayer"},
+		Type:         cal.ObservancePublic,
+		Month:        time.November,
+		Day:          16,
+		Weekday:      time.Wednesday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayFrom,
 	}
 
 	// Heiligabend represents Christmas Eve on 24-Dec
 	Heiligabend = &cal.Holiday{
+		ID:    "de/heiligabend",
 		Name:  "Heiligabend",
+		Names: map[string]string{"de": "Heiligabend", "en": "Christmas Eve"},
 		Type:  cal.ObservancePublic,
 		Month: time.December,
 		Day:   24,
@@ -109,14 +199,28 @@ var (
 	}
 
 	// Weihnachtstag represents Christmas Day on 25-Dec
-	Weihnachtstag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Weihnachtstag", Type: cal.ObservancePublic})
+	Weihnachtstag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "de/weihnachtstag",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Weihnachtstag",
+		Names:  map[string]string{"de": "Weihnachtstag", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ZweiterWeihnachtsfeiertag represents Boxing Day on 26-Dec
-	ZweiterWeihnachtsfeiertag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Zweiter Weihnachtsfeiertag", Type: cal.ObservancePublic})
+	ZweiterWeihnachtsfeiertag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "de/zweiter-weihnachtsfeiertag",
+		Source: "Feiertagsgesetze der Länder",
+		Name:   "Zweiter Weihnachtsfeiertag",
+		Names:  map[string]string{"de": "Zweiter Weihnachtsfeiertag", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Silvester represents NewYear Eve on 31 Dec
 	Silvester = &cal.Holiday{
+		ID:    "de/silvester",
 		Name:  "Silvester",
+		Names: map[string]string{"de": "Silvester", "en": "New Year's Eve"},
 		Type:  cal.ObservancePublic,
 		Month: time.December,
 		Day:   31,
@@ -370,4 +474,34 @@ var (
 		Weihnachtstag,
 		ZweiterWeihnachtsfeiertag,
 	}
+
+	// Subdivisions provides the holiday lists of each region by ISO 3166-2 code
+	Subdivisions = []*cal.Subdivision{
+		{Code: "DE-BW", Name: "Baden-Württemberg", Holidays: HolidaysBW},
+		{Code: "DE-BY", Name: "Bayern", Holidays: HolidaysBY},
+		{Code: "DE-BE", Name: "Berlin", Holidays: HolidaysBE},
+		{Code: "DE-BB", Name: "Brandenburg", Holidays: HolidaysBB},
+		{Code: "DE-HB", Name: "Bremen", Holidays: HolidaysHB},
+		{Code: "DE-HH", Name: "Hamburg", Holidays: HolidaysHH},
+		{Code: "DE-HE", Name: "Hessen", Holidays: HolidaysHE},
+		{Code: "DE-MV", Name: "Mecklenburg-Vorpommern", Holidays: HolidaysMV},
+		{Code: "DE-NI", Name: "Niedersachsen", Holidays: HolidaysNI},
+		{Code: "DE-NW", Name: "Nordrhein-Westfalen", Holidays: HolidaysNW},
+		{Code: "DE-RP", Name: "Rheinland-Pfalz", Holidays: HolidaysRP},
+		{Code: "DE-SL", Name: "Saarland", Holidays: HolidaysSL},
+		{Code: "DE-SN", Name: "Sachsen", Holidays: HolidaysSN},
+		{Code: "DE-ST", Name: "Sachsen-Anhalt", Holidays: HolidaysST},
+		{Code: "DE-SH", Name: "Schleswig-Holstein", Holidays: HolidaysSH},
+		{Code: "DE-TH", Name: "Thüringen", Holidays: HolidaysTH},
+	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:         "DE",
+		Alpha3:       "DEU",
+		Name:         "Germany",
+		Holidays:     Holidays,
+		Subdivisions: Subdivisions,
+	})
+}
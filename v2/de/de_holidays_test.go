@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/caltest"
 )
 
 func d(y, m, d int) time.Time {
@@ -204,3 +205,7 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestFixture(t *testing.T) {
+	caltest.CheckFixture(t, "DE", "testdata/holidays.csv")
+}
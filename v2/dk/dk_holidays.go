@@ -12,20 +12,42 @@ import (
 
 var (
 	// Nytaarsdag represents New Year's Day on 1-Jan
-	Nytaarsdag = aa.NewYear.Clone(&cal.Holiday{Name: "Nytårsdag", Type: cal.ObservancePublic})
+	Nytaarsdag = aa.NewYear.Clone(&cal.Holiday{
+		ID:    "dk/nytaarsdag",
+		Name:  "Nytårsdag",
+		Names: map[string]string{"da": "Nytårsdag", "en": "New Year's Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Skaertorsdag represents Maundy Thursday on the Thursday before Easter
-	Skaertorsdag = aa.MaundyThursday.Clone(&cal.Holiday{Name: "Skærtorsdag", Type: cal.ObservancePublic})
+	Skaertorsdag = aa.MaundyThursday.Clone(&cal.Holiday{
+		ID:    "dk/skaertorsdag",
+		Name:  "Skærtorsdag",
+		Names: map[string]string{"da": "Skærtorsdag", "en": "Maundy Thursday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Langfredag represents Good Friday on the Friday before Easter
-	Langfredag = aa.GoodFriday.Clone(&cal.Holiday{Name: "Langfredag", Type: cal.ObservancePublic})
+	Langfredag = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:    "dk/langfredag",
+		Name:  "Langfredag",
+		Names: map[string]string{"da": "Langfredag", "en": "Good Friday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// AndenPaaskedag represents Easter Monday on the day after Easter
-	AndenPaaskedag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Anden påskedag", Type: cal.ObservancePublic})
+	AndenPaaskedag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:    "dk/anden-paaskedag",
+		Name:  "Anden påskedag",
+		Names: map[string]string{"da": "Anden påskedag", "en": "Easter Monday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// StoreBededag represents General Prayer Day on the fourth Friday after Easter
 	StoreBededag = &cal.Holiday{
+		ID:        "dk/store-bededag",
 		Name:      "Store bededag",
+		Names:     map[string]string{"da": "Store bededag", "en": "Great Prayer Day"},
 		Type:      cal.ObservancePublic,
 		Offset:    26,
 		Func:      cal.CalcEasterOffset,
@@ -34,14 +56,26 @@ var (
 	}
 
 	// KristiHimmelfartsdag represents Ascension Day on the 39th day after Easter
-	KristiHimmelfartsdag = aa.AscensionDay.Clone(&cal.Holiday{Name: "Kristi Himmelfartsdag", Type: cal.ObservancePublic})
+	KristiHimmelfartsdag = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:    "dk/kristi-himmelfartsdag",
+		Name:  "Kristi Himmelfartsdag",
+		Names: map[string]string{"da": "Kristi Himmelfartsdag", "en": "Ascension Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// AndenPinsedag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	AndenPinsedag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Anden Pinsedag", Type: cal.ObservancePublic})
+	AndenPinsedag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:    "dk/anden-pinsedag",
+		Name:  "Anden Pinsedag",
+		Names: map[string]string{"da": "Anden Pinsedag", "en": "Whit Monday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Grundlovsdag represents Constitution Day on 5-Jun
 	Grundlovsdag = &cal.Holiday{
+		ID:    "dk/grundlovsdag",
 		Name:  "Grundlovsdag",
+		Names: map[string]string{"da": "Grundlovsdag", "en": "Constitution Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.June,
 		Day:   5,
@@ -49,10 +83,20 @@ var (
 	}
 
 	// Juledag represents Christmas Day on 25-Dec
-	Juledag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Juledag", Type: cal.ObservancePublic})
+	Juledag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:    "dk/juledag",
+		Name:  "Juledag",
+		Names: map[string]string{"da": "Juledag", "en": "Christmas Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// AndenJuledag represents the second day of Christmas on 26-Dec
-	AndenJuledag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Anden juledag", Type: cal.ObservancePublic})
+	AndenJuledag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:    "dk/anden-juledag",
+		Name:  "Anden juledag",
+		Names: map[string]string{"da": "Anden juledag", "en": "Boxing Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -68,3 +112,12 @@ var (
 		AndenJuledag,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "DK",
+		Alpha3:   "DNK",
+		Name:     "Denmark",
+		Holidays: Holidays,
+	})
+}
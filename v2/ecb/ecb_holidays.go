@@ -10,22 +10,58 @@ import (
 
 var (
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservanceBank})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "ecb/new-year",
+		Source: "TARGET2 closing days",
+		Name:   "New Year's Day",
+		Names:  map[string]string{"en": "New Year's Day"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservanceBank})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "ecb/good-friday",
+		Source: "TARGET2 closing days",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservanceBank})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "ecb/easter-monday",
+		Source: "TARGET2 closing days",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// LabourDay represents Labour Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Labour Day", Type: cal.ObservanceBank})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "ecb/labour-day",
+		Source: "TARGET2 closing days",
+		Name:   "Labour Day",
+		Names:  map[string]string{"en": "Labour Day"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "ecb/christmas-day",
+		Source: "TARGET2 closing days",
+		Name:   "Christmas Day",
+		Names:  map[string]string{"en": "Christmas Day"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// ChristmasHoliday represents the day after Christmas on 26-Dec
-	ChristmasHoliday = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Christmas Holiday", Type: cal.ObservanceBank})
+	ChristmasHoliday = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "ecb/christmas-holiday",
+		Source: "TARGET2 closing days",
+		Name:   "Christmas Holiday",
+		Names:  map[string]string{"en": "Christmas Holiday"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// Holidays provides a list of the standard ECB holidays
 	Holidays = []*cal.Holiday{
@@ -37,3 +73,11 @@ var (
 		ChristmasHoliday,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "ECB",
+		Name:     "European Central Bank",
+		Holidays: Holidays,
+	})
+}
@@ -12,70 +12,127 @@ import (
 
 var (
 	// Uusaasta represents New Year's Day on 1-Jan
-	Uusaasta = aa.NewYear.Clone(&cal.Holiday{Name: "Uusaasta", Type: cal.ObservancePublic})
+	Uusaasta = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "ee/uusaasta",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Uusaasta",
+		Names:  map[string]string{"et": "Uusaasta", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Iseseisvuspaev represents Independence Day on 24-Feb
 	Iseseisvuspaev = &cal.Holiday{
-		Name:  "Iseseisvuspäev",
-		Type:  cal.ObservancePublic,
-		Month: time.February,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ee/iseseisvuspaev",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Iseseisvuspäev",
+		Names:  map[string]string{"et": "Iseseisvuspäev", "en": "Independence Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.February,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SuurReede represents Good Friday (movable, Friday before Easter Sunday)
-	SuurReede = aa.GoodFriday.Clone(&cal.Holiday{Name: "Suur Reede", Type: cal.ObservancePublic})
+	SuurReede = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "ee/suur-reede",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Suur Reede",
+		Names:  map[string]string{"et": "Suur Reede", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Ulestousmispuhade represents Easter Sunday (movable)
-	Ulestousmispuhade = aa.Easter.Clone(&cal.Holiday{Name: "Ülestõusmispühade 1. püha", Type: cal.ObservancePublic})
+	Ulestousmispuhade = aa.Easter.Clone(&cal.Holiday{
+		ID:     "ee/ulestousmispuhade",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Ülestõusmispühade 1. püha",
+		Names:  map[string]string{"et": "Ülestõusmispühade 1. püha", "en": "Easter Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Kevadpuha represents Spring Day on 1-May
-	Kevadpuha = aa.WorkersDay.Clone(&cal.Holiday{Name: "Kevadpüha", Type: cal.ObservancePublic})
+	Kevadpuha = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "ee/kevadpuha",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Kevadpüha",
+		Names:  map[string]string{"et": "Kevadpüha", "en": "Spring Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Nelipuha represents Pentecost (movable, 49 days after Easter Sunday)
-	Nelipuha = aa.Pentecost.Clone(&cal.Holiday{Name: "Nelipühade 1. püha", Type: cal.ObservancePublic})
+	Nelipuha = aa.Pentecost.Clone(&cal.Holiday{
+		ID:     "ee/nelipuha",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Nelipühade 1. püha",
+		Names:  map[string]string{"et": "Nelipühade 1. püha", "en": "Whit Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Voidupuha represents Victory Day on 23-Jun
 	Voidupuha = &cal.Holiday{
-		Name:  "Võidupüha",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   23,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ee/voidupuha",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Võidupüha",
+		Names:  map[string]string{"et": "Võidupüha", "en": "Victory Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    23,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Jaanipaev represents Midsummer Day on 24-Jun
 	Jaanipaev = &cal.Holiday{
-		Name:  "Jaanipäev",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ee/jaanipaev",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Jaanipäev",
+		Names:  map[string]string{"et": "Jaanipäev", "en": "Midsummer Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Taasiseseisvuspaev represents Day of Restoration of Independence on 20-Aug
 	Taasiseseisvuspaev = &cal.Holiday{
-		Name:  "Taasiseseisvumispäev",
-		Type:  cal.ObservancePublic,
-		Month: time.August,
-		Day:   20,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ee/taasiseseisvuspaev",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Taasiseseisvumispäev",
+		Names:  map[string]string{"et": "Taasiseseisvumispäev", "en": "Day of Restoration of Independence"},
+		Type:   cal.ObservancePublic,
+		Month:  time.August,
+		Day:    20,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Joululaupaev represents Christmas Eve on 24-Dec
 	Joululaupaev = &cal.Holiday{
-		Name:  "Jõululaupäev",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ee/joululaupaev",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Jõululaupäev",
+		Names:  map[string]string{"et": "Jõululaupäev", "en": "Christmas Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// EsimeneJoulupuha represents Christmas Day on 25-Dec
-	EsimeneJoulupuha = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Esimene jõulupüha", Type: cal.ObservancePublic})
+	EsimeneJoulupuha = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "ee/esimene-joulupuha",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Esimene jõulupüha",
+		Names:  map[string]string{"et": "Esimene jõulupüha", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TeineJoulupuha represents Boxing Day (Second Day of Christmas) on 26-Dec
-	TeineJoulupuha = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Teine jõulupüha", Type: cal.ObservancePublic})
+	TeineJoulupuha = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "ee/teine-joulupuha",
+		Source: "Pühade ja tähtpäevade seadus",
+		Name:   "Teine jõulupüha",
+		Names:  map[string]string{"et": "Teine jõulupüha", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -93,3 +150,12 @@ var (
 		TeineJoulupuha,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "EE",
+		Alpha3:   "EST",
+		Name:     "Estonia",
+		Holidays: Holidays,
+	})
+}
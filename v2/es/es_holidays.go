@@ -12,46 +12,100 @@ import (
 
 var (
 	// AñoNuevo represents New Year's Day on 1-Jan
-	AñoNuevo = aa.NewYear.Clone(&cal.Holiday{Name: "Año Nuevo", Type: cal.ObservancePublic})
+	AñoNuevo = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "es/ano-nuevo",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Año Nuevo",
+		Names:  map[string]string{"es": "Año Nuevo", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Reyes represents Epiphany on 6-Jan
-	Reyes = aa.Epiphany.Clone(&cal.Holiday{Name: "Día de Reyes", Type: cal.ObservancePublic})
+	Reyes = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "es/reyes",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Día de Reyes",
+		Names:  map[string]string{"es": "Día de Reyes", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ViernesSanto represents Good Friday on the Friday before Easter
-	ViernesSanto = aa.GoodFriday.Clone(&cal.Holiday{Name: "Viernes Santo", Type: cal.ObservancePublic})
+	ViernesSanto = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "es/viernes-santo",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Viernes Santo",
+		Names:  map[string]string{"es": "Viernes Santo", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Trabajador represents Labour Day on 1-May
-	Trabajador = aa.WorkersDay.Clone(&cal.Holiday{Name: "Día del Trabajador", Type: cal.ObservancePublic})
+	Trabajador = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "es/trabajador",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Día del Trabajador",
+		Names:  map[string]string{"es": "Día del Trabajador", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Asunción represents Assumption of Mary on 15-Aug
-	Asunción = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Asunción", Type: cal.ObservancePublic})
+	Asunción = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "es/asuncion",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Asunción",
+		Names:  map[string]string{"es": "Asunción", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FiestaNacionalDeEspaña represents Spanish National Day on 12-Oct
 	FiestaNacionalDeEspaña = &cal.Holiday{
-		Name:  "Fiesta Nacional de España",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   12,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "es/fiesta-nacional-de-espana",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Fiesta Nacional de España",
+		Names:  map[string]string{"es": "Fiesta Nacional de España", "en": "National Day of Spain"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    12,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// TodosLosSantos represents All Saints' Day on 1-Nov
-	TodosLosSantos = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Día de todos los Santos", Type: cal.ObservancePublic})
+	TodosLosSantos = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "es/todos-los-santos",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Día de todos los Santos",
+		Names:  map[string]string{"es": "Día de todos los Santos", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Constitucion represents Spanish Constitution Day on 6-Dec
 	Constitucion = &cal.Holiday{
-		Name:  "Día de la Constitución",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   6,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "es/constitucion",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Día de la Constitución",
+		Names:  map[string]string{"es": "Día de la Constitución", "en": "Constitution Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    6,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// InmaculadaConcepcion represents Immaculate Conception on 8-Dec
-	InmaculadaConcepcion = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Inmaculada Concepción", Type: cal.ObservancePublic})
+	InmaculadaConcepcion = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:     "es/inmaculada-concepcion",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Inmaculada Concepción",
+		Names:  map[string]string{"es": "Inmaculada Concepción", "en": "Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Navidad represents Christmas Day on 25-Dec
-	Navidad = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Navidad", Type: cal.ObservancePublic})
+	Navidad = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "es/navidad",
+		Source: "Estatuto de los Trabajadores, art. 37.2",
+		Name:   "Navidad",
+		Names:  map[string]string{"es": "Navidad", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -67,3 +121,12 @@ var (
 		Navidad,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "ES",
+		Alpha3:   "ESP",
+		Name:     "Spain",
+		Holidays: Holidays,
+	})
+}
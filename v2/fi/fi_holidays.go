@@ -10,32 +10,74 @@ import (
 
 var (
 	// Uudenvuodenpäivä represents New Year's Day on 1-Jan
-	Uudenvuodenpaiva = aa.NewYear.Clone(&cal.Holiday{Name: "Uudenvuodenpäivä", Type: cal.ObservancePublic})
+	Uudenvuodenpaiva = aa.NewYear.Clone(&cal.Holiday{
+		ID:    "fi/uudenvuodenpaiva",
+		Name:  "Uudenvuodenpäivä",
+		Names: map[string]string{"fi": "Uudenvuodenpäivä", "sv": "Nyårsdagen", "en": "New Year's Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Loppiainen represents Epiphany on 6-Jan
-	Loppiainen = aa.Epiphany.Clone(&cal.Holiday{Name: "Loppiainen", Type: cal.ObservancePublic})
+	Loppiainen = aa.Epiphany.Clone(&cal.Holiday{
+		ID:    "fi/loppiainen",
+		Name:  "Loppiainen",
+		Names: map[string]string{"fi": "Loppiainen", "sv": "Trettondedag jul", "en": "Epiphany"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Pitkäperjantai represents Good Friday on the Friday before Easter
-	Pitkaperjantai = aa.GoodFriday.Clone(&cal.Holiday{Name: "Pitkäperjantai", Type: cal.ObservancePublic})
+	Pitkaperjantai = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:    "fi/pitkaperjantai",
+		Name:  "Pitkäperjantai",
+		Names: map[string]string{"fi": "Pitkäperjantai", "sv": "Långfredagen", "en": "Good Friday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Pääsiäispäivä represents the day of Easter
-	Paasiaispaiva = aa.Easter.Clone(&cal.Holiday{Name: "Pääsiäispäivä", Type: cal.ObservancePublic})
+	Paasiaispaiva = aa.Easter.Clone(&cal.Holiday{
+		ID:    "fi/paasiaispaiva",
+		Name:  "Pääsiäispäivä",
+		Names: map[string]string{"fi": "Pääsiäispäivä", "sv": "Påskdagen", "en": "Easter Sunday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Toinen pääsiäispäivä represents Easter Monday on the day after Easter
-	ToinenPaasiaispaiva = aa.EasterMonday.Clone(&cal.Holiday{Name: "Toinen pääsiäispäivä", Type: cal.ObservancePublic})
+	ToinenPaasiaispaiva = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:    "fi/toinen-paasiaispaiva",
+		Name:  "Toinen pääsiäispäivä",
+		Names: map[string]string{"fi": "Toinen pääsiäispäivä", "sv": "Annandag påsk", "en": "Easter Monday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Vappu represents Labour Day on 1-May
-	Vappu = aa.WorkersDay.Clone(&cal.Holiday{Name: "Vappu", Type: cal.ObservancePublic})
+	Vappu = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:    "fi/vappu",
+		Name:  "Vappu",
+		Names: map[string]string{"fi": "Vappu", "sv": "Första maj", "en": "May Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Helatorstai represents Ascension Day on the 39th day after Easter
-	Helatorstai = aa.AscensionDay.Clone(&cal.Holiday{Name: "Helatorstai", Type: cal.ObservancePublic})
+	Helatorstai = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:    "fi/helatorstai",
+		Name:  "Helatorstai",
+		Names: map[string]string{"fi": "Helatorstai", "sv": "Kristi himmelfärdsdag", "en": "Ascension Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Helluntaipäivä represents Pentecost Sunday on the 49th day after Easter
-	Helluntaipaiva = aa.Pentecost.Clone(&cal.Holiday{Name: "Helluntaipäivä", Type: cal.ObservancePublic})
+	Helluntaipaiva = aa.Pentecost.Clone(&cal.Holiday{
+		ID:    "fi/helluntaipaiva",
+		Name:  "Helluntaipäivä",
+		Names: map[string]string{"fi": "Helluntaipäivä", "sv": "Pingstdagen", "en": "Whit Sunday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Juhannusaatto represents Midsummer's Eve on the day before Midsummer's Day
 	Juhannusaatto = &cal.Holiday{
+		ID:      "fi/juhannusaatto",
 		Name:    "Juhannusaatto",
+		Names:   map[string]string{"fi": "Juhannusaatto", "sv": "Midsommarafton", "en": "Midsummer Eve"},
 		Type:    cal.ObservancePublic,
 		Month:   time.June,
 		Day:     19,
@@ -46,7 +88,9 @@ var (
 
 	// Juhannuspäivä represents Midsummer's Day on the first Saturday from 20-Jun
 	Juhannuspaiva = &cal.Holiday{
+		ID:      "fi/juhannuspaiva",
 		Name:    "Juhannuspäivä",
+		Names:   map[string]string{"fi": "Juhannuspäivä", "sv": "Midsommardagen", "en": "Midsummer Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.June,
 		Day:     20,
@@ -57,7 +101,9 @@ var (
 
 	// Pyhäinpäivä represents All Saints' Day on the first Saturday from 31-Oct
 	Pyhainpaiva = &cal.Holiday{
+		ID:      "fi/pyhainpaiva",
 		Name:    "Pyhäinpäivä",
+		Names:   map[string]string{"fi": "Pyhäinpäivä", "sv": "Alla helgons dag", "en": "All Saints' Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Day:     31,
@@ -68,7 +114,9 @@ var (
 
 	// Itsenäisyyspäivä represents National Day of Finland on 6-Dec
 	Itsenaisyyspaiva = &cal.Holiday{
+		ID:    "fi/itsenaisyyspaiva",
 		Name:  "Itsenäisyyspäivä",
+		Names: map[string]string{"fi": "Itsenäisyyspäivä", "sv": "Självständighetsdagen", "en": "Independence Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.December,
 		Day:   6,
@@ -77,7 +125,9 @@ var (
 
 	// Jouluaatto represents Christmas Eve on 24-Dec
 	Jouluaatto = &cal.Holiday{
+		ID:    "fi/jouluaatto",
 		Name:  "Jouluaatto",
+		Names: map[string]string{"fi": "Jouluaatto", "sv": "Julafton", "en": "Christmas Eve"},
 		Type:  cal.ObservanceOther,
 		Month: time.December,
 		Day:   24,
@@ -85,10 +135,20 @@ var (
 	}
 
 	// Joulupäivä represents Christmas Day on 25-Dec
-	Joulupaiva = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Joulupäivä", Type: cal.ObservancePublic})
+	Joulupaiva = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:    "fi/joulupaiva",
+		Name:  "Joulupäivä",
+		Names: map[string]string{"fi": "Joulupäivä", "sv": "Juldagen", "en": "Christmas Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Tapaninpäivä represents the second day of Christmas on 26-Dec
-	Tapaninpaiva = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Tapaninpäivä", Type: cal.ObservancePublic})
+	Tapaninpaiva = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:    "fi/tapaninpaiva",
+		Name:  "Tapaninpäivä",
+		Names: map[string]string{"fi": "Tapaninpäivä", "sv": "Annandag jul", "en": "St. Stephen's Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -109,3 +169,12 @@ var (
 		Tapaninpaiva,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "FI",
+		Alpha3:   "FIN",
+		Name:     "Finland",
+		Holidays: Holidays,
+	})
+}
@@ -12,49 +12,109 @@ import (
 
 var (
 	// NouvelAn represents New Year's Day on 1-Jan
-	NouvelAn = aa.NewYear.Clone(&cal.Holiday{Name: "Nouvel an", Type: cal.ObservancePublic})
+	NouvelAn = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "fr/nouvel-an",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Nouvel an",
+		Names:  map[string]string{"fr": "Nouvel an", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LundiDePâques represents Easter Monday on the day after Easter
-	LundiDePâques = aa.EasterMonday.Clone(&cal.Holiday{Name: "Lundi de Pâques", Type: cal.ObservancePublic})
+	LundiDePâques = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "fr/lundi-de-paques",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Lundi de Pâques",
+		Names:  map[string]string{"fr": "Lundi de Pâques", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FêteDuTravail represents Labour Day on 1-May
-	FêteDuTravail = aa.WorkersDay.Clone(&cal.Holiday{Name: "Fête du Travail", Type: cal.ObservancePublic})
+	FêteDuTravail = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "fr/fete-du-travail",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Fête du Travail",
+		Names:  map[string]string{"fr": "Fête du Travail", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FêteDeLaVictoire represents Victory in Europe Day on 8-May
 	FêteDeLaVictoire = &cal.Holiday{
-		Name:  "Fête de la Victoire",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "fr/fete-de-la-victoire",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Fête de la Victoire",
+		Names:  map[string]string{"fr": "Fête de la Victoire", "en": "Victory in Europe Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Ascension represents Ascension Day on the 39th day after Easter
-	Ascension = aa.AscensionDay.Clone(&cal.Holiday{Name: "Ascension", Type: cal.ObservancePublic})
+	Ascension = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "fr/ascension",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Ascension",
+		Names:  map[string]string{"fr": "Ascension", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LundiDePentecôte represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	LundiDePentecôte = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Lundi de Pentecôte", Type: cal.ObservancePublic})
+	LundiDePentecôte = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "fr/lundi-de-pentecote",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Lundi de Pentecôte",
+		Names:  map[string]string{"fr": "Lundi de Pentecôte", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FêteNationale represents Bastille Day on 14-Jul
 	FêteNationale = &cal.Holiday{
-		Name:  "Fête Nationale",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   14,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "fr/fete-nationale",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Fête Nationale",
+		Names:  map[string]string{"fr": "Fête Nationale", "en": "Bastille Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    14,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Assomption represents Assumption of Mary on 15-Aug
-	Assomption = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Assomption", Type: cal.ObservancePublic})
+	Assomption = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "fr/assomption",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Assomption",
+		Names:  map[string]string{"fr": "Assomption", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Toussaint represents All Saints' Day on 1-Nov
-	Toussaint = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Toussaint", Type: cal.ObservancePublic})
+	Toussaint = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "fr/toussaint",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Toussaint",
+		Names:  map[string]string{"fr": "Toussaint", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Armistice1918 represents Armistice Day on 11-Nov
-	Armistice1918 = aa.ArmisticeDay.Clone(&cal.Holiday{Name: "Armistice de 1918", Type: cal.ObservancePublic})
+	Armistice1918 = aa.ArmisticeDay.Clone(&cal.Holiday{
+		ID:     "fr/armistice-1918",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Armistice de 1918",
+		Names:  map[string]string{"fr": "Armistice de 1918", "en": "Armistice Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Noël represents Christmas Day on 25-Dec
-	Noël = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Noël", Type: cal.ObservancePublic})
+	Noël = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "fr/noel",
+		Source: "Code du travail, article L3133-1",
+		Name:   "Noël",
+		Names:  map[string]string{"fr": "Noël", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -71,3 +131,12 @@ var (
 		Noël,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "FR",
+		Alpha3:   "FRA",
+		Name:     "France",
+		Holidays: Holidays,
+	})
+}
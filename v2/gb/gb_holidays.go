@@ -20,17 +20,39 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "gb/new-year",
+		Source:   "Royal proclamation under the Banking and Financial Dealings Act 1971",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservanceBank})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "gb/good-friday",
+		Source: "Common law holiday",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservanceBank})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "gb/easter-monday",
+		Source: "Banking and Financial Dealings Act 1971, Sch. 1",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
+		Type:   cal.ObservanceBank,
+	})
 
 	// EarlyMay represents Early May on the first Monday of May
 	EarlyMay = &cal.Holiday{
+		ID:      "gb/early-may",
+		Source:  "Royal proclamation under the Banking and Financial Dealings Act 1971",
 		Name:    "Early May",
+		Names:   map[string]string{"en": "Early May"},
 		Type:    cal.ObservanceBank,
 		Month:   time.May,
 		Weekday: time.Monday,
@@ -41,7 +63,10 @@ var (
 
 	// VEDay represents VE Day, the 75th anniversary of the end of WWII.
 	VEDay = &cal.Holiday{
+		ID:        "gb/ve-day",
+		Source:    "Royal proclamation under the Banking and Financial Dealings Act 1971",
 		Name:      "VE Day",
+		Names:     map[string]string{"en": "VE Day"},
 		Type:      cal.ObservanceBank,
 		Month:     time.May,
 		Day:       8,
@@ -52,7 +77,10 @@ var (
 
 	// CoronationDay represents the Coroation Day for King Charles III on 8-May
 	CoronationDay = &cal.Holiday{
+		ID:        "gb/coronation-day",
+		Source:    "Royal proclamation under the Banking and Financial Dealings Act 1971",
 		Name:      "Coronation of King Charles III",
+		Names:     map[string]string{"en": "Coronation of King Charles III"},
 		Type:      cal.ObservanceBank,
 		Month:     time.May,
 		Day:       8,
@@ -63,7 +91,10 @@ var (
 
 	// SpringHoliday represents Spring Bank Holiday on the last Monday of May
 	SpringHoliday = &cal.Holiday{
+		ID:      "gb/spring-holiday",
+		Source:  "Banking and Financial Dealings Act 1971, Sch. 1",
 		Name:    "Spring Bank Holiday",
+		Names:   map[string]string{"en": "Spring Bank Holiday"},
 		Type:    cal.ObservanceBank,
 		Month:   time.May,
 		Weekday: time.Monday,
@@ -74,7 +105,10 @@ var (
 
 	// SpringHoliday2022 represents Spring Bank Holiday in 2022 only on 2-Jun
 	SpringHoliday2022 = &cal.Holiday{
+		ID:        "gb/spring-holiday-2022",
+		Source:    "Royal proclamation under the Banking and Financial Dealings Act 1971",
 		Name:      "Spring Bank Holiday",
+		Names:     map[string]string{"en": "Spring Bank Holiday"},
 		Type:      cal.ObservanceBank,
 		Month:     time.June,
 		Day:       2,
@@ -85,7 +119,10 @@ var (
 
 	// PlatinumJubilee represents Platinum Jubilee Bank Holiday in 2022 only on 3-Jun
 	PlatinumJubilee = &cal.Holiday{
+		ID:        "gb/platinum-jubilee",
+		Source:    "Royal proclamation under the Banking and Financial Dealings Act 1971",
 		Name:      "Platinum Jubilee Bank Holiday",
+		Names:     map[string]string{"en": "Platinum Jubilee Bank Holiday"},
 		Type:      cal.ObservanceBank,
 		Month:     time.June,
 		Day:       3,
@@ -96,17 +133,24 @@ var (
 
 	// SummerHolidayScotland represents Summer Bank Holiday in Scotland on the first Monday of August
 	SummerHolidayScotland = &cal.Holiday{
-		Name:    "Summer Bank Holiday",
-		Type:    cal.ObservanceBank,
-		Month:   time.August,
-		Weekday: time.Monday,
-		Offset:  1,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "gb/summer-holiday-scotland",
+		Source:       "Banking and Financial Dealings Act 1971, Sch. 1",
+		Subdivisions: []string{"GB-SCT"},
+		Name:         "Summer Bank Holiday",
+		Names:        map[string]string{"en": "Summer Bank Holiday"},
+		Type:         cal.ObservanceBank,
+		Month:        time.August,
+		Weekday:      time.Monday,
+		Offset:       1,
+		Func:         cal.CalcWeekdayOffset,
 	}
 
 	// SummerHoliday represents Summer Bank Holiday on the last Monday of August
 	SummerHoliday = &cal.Holiday{
+		ID:      "gb/summer-holiday",
+		Source:  "Banking and Financial Dealings Act 1971, Sch. 1",
 		Name:    "Summer Bank Holiday",
+		Names:   map[string]string{"en": "Summer Bank Holiday"},
 		Type:    cal.ObservanceBank,
 		Month:   time.August,
 		Weekday: time.Monday,
@@ -114,11 +158,38 @@ var (
 		Func:    cal.CalcWeekdayOffset,
 	}
 
+	// StateFuneral represents the Bank Holiday for the State Funeral of Queen
+	// Elizabeth II in 2022 only on 19-Sep
+	StateFuneral = &cal.Holiday{
+		ID:        "gb/state-funeral",
+		Source:    "Royal proclamation under the Banking and Financial Dealings Act 1971",
+		Name:      "Bank Holiday for the State Funeral of Queen Elizabeth II",
+		Names:     map[string]string{"en": "Bank Holiday for the State Funeral of Queen Elizabeth II"},
+		Type:      cal.ObservanceBank,
+		Month:     time.September,
+		Day:       19,
+		Func:      cal.CalcDayOfMonth,
+		StartYear: 2022,
+		EndYear:   2022,
+	}
+
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "gb/christmas-day",
+		Source:   "Common law holiday",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// BoxingDay represents Boxing Day on 26-Dec
-	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Boxing Day", Type: cal.ObservanceBank,
+	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "gb/boxing-day",
+		Source: "Banking and Financial Dealings Act 1971, Sch. 1",
+		Name:   "Boxing Day",
+		Names:  map[string]string{"en": "Boxing Day"},
+		Type:   cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
@@ -136,7 +207,17 @@ var (
 		SpringHoliday2022,
 		PlatinumJubilee,
 		SummerHoliday,
+		StateFuneral,
 		ChristmasDay,
 		BoxingDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "GB",
+		Alpha3:   "GBR",
+		Name:     "United Kingdom",
+		Holidays: Holidays,
+	})
+}
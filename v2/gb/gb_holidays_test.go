@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/caltest"
 )
 
 func d(y, m, d int) time.Time {
@@ -92,6 +93,10 @@ func TestHolidays(t *testing.T) {
 		{PlatinumJubilee, 2022, d(2022, 6, 3), d(2022, 6, 3)},
 		{PlatinumJubilee, 2023, time.Time{}, time.Time{}},
 
+		{StateFuneral, 2021, time.Time{}, time.Time{}},
+		{StateFuneral, 2022, d(2022, 9, 19), d(2022, 9, 19)},
+		{StateFuneral, 2023, time.Time{}, time.Time{}},
+
 		{SummerHolidayScotland, 2015, d(2015, 8, 3), d(2015, 8, 3)},
 		{SummerHolidayScotland, 2016, d(2016, 8, 1), d(2016, 8, 1)},
 		{SummerHolidayScotland, 2017, d(2017, 8, 7), d(2017, 8, 7)},
@@ -139,3 +144,7 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestFixture(t *testing.T) {
+	caltest.CheckFixture(t, "GB", "testdata/holidays.csv")
+}
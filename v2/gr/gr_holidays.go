@@ -9,17 +9,26 @@ import (
 
 var (
 	// Xristougenna respresents New Year's Day on 1-Jan
-	Protoxronia = aa.NewYear.Clone(&cal.Holiday{Name: "Xristougenna", Type: cal.ObservancePublic})
+	Protoxronia = aa.NewYear.Clone(&cal.Holiday{
+		ID:    "gr/protoxronia",
+		Name:  "Xristougenna",
+		Names: map[string]string{"el": "Πρωτοχρονιά", "en": "New Year's Day"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// Theophania represents Epiphany on 6-Jan
 	Theophania = aa.Epiphany.Clone(&cal.Holiday{
-		Name: "Θεοφάνεια",
-		Type: cal.ObservancePublic,
+		ID:    "gr/theophania",
+		Name:  "Θεοφάνεια",
+		Names: map[string]string{"el": "Θεοφάνεια", "en": "Epiphany"},
+		Type:  cal.ObservancePublic,
 	})
 
 	// Kathara Deftera represents the first day of the Lent
 	KatharaDeftera = &cal.Holiday{
+		ID:     "gr/kathara-deftera",
 		Name:   "Καθαρά Δευτέρα",
+		Names:  map[string]string{"el": "Καθαρά Δευτέρα", "en": "Clean Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: -48,
 		Julian: true,
@@ -28,7 +37,9 @@ var (
 
 	// Ikosti Pempti Martiou (Independence Day) is the Anniversary of the declaration of the start of Greek War of Independence from the Ottoman Empire, in 1821.
 	IkostiPemptiMartiou = &cal.Holiday{
+		ID:    "gr/ikosti-pempti-martiou",
 		Name:  "Εικοστή Πέμπτη Μαρτίου",
+		Names: map[string]string{"el": "Εικοστή Πέμπτη Μαρτίου", "en": "Greek Independence Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.March,
 		Day:   25,
@@ -37,7 +48,9 @@ var (
 
 	// Megali Paraskevi represents Good Friday - two days before Easter
 	MegaliParaskevi = &cal.Holiday{
+		ID:     "gr/megali-paraskevi",
 		Name:   "Μεγάλη Παρασκευή",
+		Names:  map[string]string{"el": "Μεγάλη Παρασκευή", "en": "Good Friday"},
 		Type:   cal.ObservancePublic,
 		Offset: -2,
 		Julian: true,
@@ -46,7 +59,9 @@ var (
 
 	// DefteraPascha represents Easter Monday on the day after Easter
 	DefteraPascha = &cal.Holiday{
+		ID:     "gr/deftera-pascha",
 		Name:   "Δευτέρα του Πάσχα",
+		Names:  map[string]string{"el": "Δευτέρα του Πάσχα", "en": "Easter Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 1,
 		Julian: true,
@@ -55,13 +70,17 @@ var (
 
 	// Ergatiki Protomagia represents Labour Day on 1-May
 	ErgatikiProtomagia = aa.WorkersDay.Clone(&cal.Holiday{
-		Name: "Εργατική Πρωτομαγιά",
-		Type: cal.ObservancePublic,
+		ID:    "gr/ergatiki-protomagia",
+		Name:  "Εργατική Πρωτομαγιά",
+		Names: map[string]string{"el": "Εργατική Πρωτομαγιά", "en": "Labour Day"},
+		Type:  cal.ObservancePublic,
 	})
 
 	// Agiou Prevmatos represents Pentecost Monday on the day after Pentecost (50 days after Easter)
 	AgiouPrevmatos = &cal.Holiday{
+		ID:     "gr/agiou-prevmatos",
 		Name:   "Αγίου Πνεύματος",
+		Names:  map[string]string{"el": "Αγίου Πνεύματος", "en": "Whit Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 50,
 		Julian: true,
@@ -70,13 +89,17 @@ var (
 
 	// Kimisi tis Theotokou represents Assumption of Mary on 15-Aug
 	KimisiTisTheotokou = aa.AssumptionOfMary.Clone(&cal.Holiday{
-		Name: "Κοίμηση της Θεοτόκου",
-		Type: cal.ObservancePublic,
+		ID:    "gr/kimisi-tis-theotokou",
+		Name:  "Κοίμηση της Θεοτόκου",
+		Names: map[string]string{"el": "Κοίμηση της Θεοτόκου", "en": "Assumption Day"},
+		Type:  cal.ObservancePublic,
 	})
 
 	// Imera tou Ochi represents Celebration of the Greek refusal to the Italian ultimatum of 1940.
 	ImeraTouOchi = &cal.Holiday{
+		ID:    "gr/imera-tou-ochi",
 		Name:  "Ημέρα του Όχι",
+		Names: map[string]string{"el": "Ημέρα του Όχι", "en": "Ochi Day"},
 		Type:  cal.ObservancePublic,
 		Month: time.October,
 		Day:   28,
@@ -85,14 +108,18 @@ var (
 
 	// Christougenna represents Christmas Day on 25-Dec
 	Christougenna = aa.ChristmasDay.Clone(&cal.Holiday{
-		Name: "Χριστούγεννα",
-		Type: cal.ObservancePublic,
+		ID:    "gr/christougenna",
+		Name:  "Χριστούγεννα",
+		Names: map[string]string{"el": "Χριστούγεννα", "en": "Christmas Day"},
+		Type:  cal.ObservancePublic,
 	})
 
 	// Sínaxis Yperagías Theotókou Marías respresents the holiday to glorify the Theotokos
 	SinaxisYperagiasTheotokou = aa.ChristmasDay2.Clone(&cal.Holiday{
-		Name: "Σύναξις Υπεραγίας Θεοτόκου Μαρίας",
-		Type: cal.ObservancePublic,
+		ID:    "gr/sinaxis-yperagias-theotokou",
+		Name:  "Σύναξις Υπεραγίας Θεοτόκου Μαρίας",
+		Names: map[string]string{"el": "Σύναξις Υπεραγίας Θεοτόκου Μαρίας", "en": "Synaxis of the Mother of God"},
+		Type:  cal.ObservancePublic,
 	})
 
 	// Holidays provides a list of the standard national holidays
@@ -111,3 +138,12 @@ var (
 		SinaxisYperagiasTheotokou,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "GR",
+		Alpha3:   "GRC",
+		Name:     "Greece",
+		Holidays: Holidays,
+	})
+}
@@ -3,6 +3,8 @@
 package cal
 
 import (
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -19,12 +21,19 @@ const (
 )
 
 // HolidayFn calculates the expected occurrence of a holiday for the given year.
-// Returned times are the start of the day in the system location.
+// Returned times are the start of the day in the given location.
 //
 // Note that implementations of this function should always return the day
 // that the holiday is expected to occur. Additional rules for substitution days
-// and exception years will be applied by Holiday.Calc().
-type HolidayFn func(h *Holiday, year int) time.Time
+// and exception years will be applied by Holiday.CalcIn().
+type HolidayFn func(h *Holiday, year int, loc *time.Location) time.Time
+
+// ObservedFn calculates the observed date of a holiday from its actual date.
+//
+// This is useful for substitution rules that can't be expressed as a fixed
+// list of AltDay values, such as rules that depend on the other holidays in the
+// same year. If no substitution applies, the actual date should be returned.
+type ObservedFn func(h *Holiday, actual time.Time) time.Time
 
 // AltDay represents an alternative day to observe a holiday.
 type AltDay struct {
@@ -34,54 +43,86 @@ type AltDay struct {
 
 // Holiday holds information about the type and occurrence of a holiday.
 type Holiday struct {
-	Name        string         // name in local language
-	Description string         // further details/notes
-	Type        ObservanceType // type of day being observed
-	StartYear   int            // the first year the holiday is observed
-	EndYear     int            // the last year the holiday is observed
-	Except      []int          // years where the holiday doesn't apply
+	ID           string            // stable identifier, e.g. "gb/boxing-day"
+	BaseID       string            // identifier of the holiday this one was cloned from, if it has a different ID
+	Name         string            // name in local language
+	Names        map[string]string // names keyed by BCP 47 language tag, e.g. "en" or "fr-CH"
+	Description  string            // further details/notes
+	Subdivisions []string          // ISO 3166-2 codes of the regions that observe the holiday; nil if nationwide
+	Source       string            // legal reference or other source for the holiday
+	Type         ObservanceType    // type of day being observed
+	StartYear    int               // the first year the holiday is observed
+	EndYear      int               // the last year the holiday is observed
+	Except       []int             // years where the holiday doesn't apply
 
 	// calculation fields; required fields depend on rule being followed
-	Month      time.Month   // the month the holiday occurs
-	Day        int          // the day the holiday occurs
-	Weekday    time.Weekday // the weekday the holiday occurs
-	Offset     int          // the weekday or start date offset the holiday occurs
-	CalcOffset int          // days offset from the date the holiday occurs, applied after the calculation
-	Julian     bool         // the holiday is based on a Julian calendar
-	Observed   []AltDay     // the substitution days for the holiday
-	Func       HolidayFn    // logic used to determine occurrences
+	Month        time.Month   // the month the holiday occurs
+	Day          int          // the day the holiday occurs
+	Weekday      time.Weekday // the weekday the holiday occurs
+	Offset       int          // the weekday or start date offset the holiday occurs
+	CalcOffset   int          // days offset from the date the holiday occurs, applied after the calculation
+	Julian       bool         // the holiday is based on a Julian calendar
+	Observed     []AltDay     // the substitution days for the holiday
+	Func         HolidayFn    // logic used to determine occurrences
+	ObservedFunc ObservedFn   // optional logic used to determine observance; overrides Observed
 }
 
 // Clone returns a copy of the Holiday. If overrides is non-nil, then the
 // field values set in overrides will be used instead of the original values.
 //
-// The following fields can be set in overrides: Name, Description, Type,
-// StartYear, EndYear, Except, Observed.
+// The following fields can be set in overrides: ID, Name, Names, Description,
+// Subdivisions, Source, Type, StartYear, EndYear, Except, Observed,
+// ObservedFunc. Names in overrides are added to the original names, replacing
+// names with the same tag.
+//
+// The ID is kept unless overrides sets a different one, in which case BaseID
+// is set to the original ID so that the holidays can still be matched.
 func (h *Holiday) Clone(overrides *Holiday) *Holiday {
 	val := &Holiday{
-		Name:        h.Name,
-		Description: h.Description,
-		Type:        h.Type,
-		StartYear:   h.StartYear,
-		EndYear:     h.EndYear,
-		Except:      h.Except,
-		Month:       h.Month,
-		Day:         h.Day,
-		Weekday:     h.Weekday,
-		Offset:      h.Offset,
-		CalcOffset:  h.CalcOffset,
-		Julian:      h.Julian,
-		Observed:    h.Observed,
-		Func:        h.Func,
+		ID:           h.ID,
+		BaseID:       h.BaseID,
+		Name:         h.Name,
+		Names:        copyNames(h.Names, nil),
+		Description:  h.Description,
+		Subdivisions: h.Subdivisions,
+		Source:       h.Source,
+		Type:         h.Type,
+		StartYear:    h.StartYear,
+		EndYear:      h.EndYear,
+		Except:       h.Except,
+		Month:        h.Month,
+		Day:          h.Day,
+		Weekday:      h.Weekday,
+		Offset:       h.Offset,
+		CalcOffset:   h.CalcOffset,
+		Julian:       h.Julian,
+		Observed:     h.Observed,
+		Func:         h.Func,
+		ObservedFunc: h.ObservedFunc,
 	}
 
 	if overrides != nil {
+		if overrides.ID != "" && overrides.ID != h.ID {
+			if val.BaseID == "" {
+				val.BaseID = h.ID
+			}
+			val.ID = overrides.ID
+		}
 		if overrides.Name != "" {
 			val.Name = overrides.Name
 		}
+		if overrides.Names != nil {
+			val.Names = copyNames(val.Names, overrides.Names)
+		}
 		if overrides.Description != "" {
 			val.Description = overrides.Description
 		}
+		if overrides.Subdivisions != nil {
+			val.Subdivisions = overrides.Subdivisions
+		}
+		if overrides.Source != "" {
+			val.Source = overrides.Source
+		}
 		if overrides.Type != ObservanceUnknown {
 			val.Type = overrides.Type
 		}
@@ -97,16 +138,90 @@ func (h *Holiday) Clone(overrides *Holiday) *Holiday {
 		if overrides.Observed != nil {
 			val.Observed = overrides.Observed
 		}
+		if overrides.ObservedFunc != nil {
+			val.ObservedFunc = overrides.ObservedFunc
+		}
 	}
 
 	return val
 }
 
+// copyNames returns a new map with the names in a followed by the names in b.
+// If both are nil, nil is returned.
+func copyNames(a, b map[string]string) map[string]string {
+	if a == nil && b == nil {
+		return nil
+	}
+	r := make(map[string]string, len(a)+len(b))
+	for _, m := range []map[string]string{a, b} {
+		for k, v := range m {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// NameIn reports the holiday's name in the first of the given languages that
+// it has a name for. Languages are BCP 47 tags such as "de" or "fr-CH", and are
+// matched without regard to case.
+//
+// Each tag falls back to less specific tags by removing subtags from the end
+// ("zh-Hant-TW", "zh-Hant", "zh") and then to any other variant of its
+// language ("fr" matches "fr-CH"). If no language matches, Name is returned.
+func (h *Holiday) NameIn(tags ...string) string {
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Replace(tag, "_", "-", -1))
+		for t := tag; t != ""; t = parentTag(t) {
+			for k, v := range h.Names {
+				if strings.ToLower(k) == t {
+					return v
+				}
+			}
+		}
+
+		var variants []string
+		base := tag
+		if i := strings.IndexByte(tag, '-'); i >= 0 {
+			base = tag[:i]
+		}
+		for k := range h.Names {
+			if strings.HasPrefix(strings.ToLower(k), base+"-") {
+				variants = append(variants, k)
+			}
+		}
+		if len(variants) > 0 {
+			sort.Strings(variants)
+			return h.Names[variants[0]]
+		}
+	}
+	return h.Name
+}
+
+// parentTag reports the language tag without its last subtag, or "" if the
+// tag has no subtags.
+func parentTag(tag string) string {
+	i := strings.LastIndexByte(tag, '-')
+	if i < 0 {
+		return ""
+	}
+	return tag[:i]
+}
+
 // Calc reports the actual and observed dates of a holiday for the given year.
 // If the holiday is not observed in the given year, the zero time is returned.
 //
-// Returned times are the start of the day in the system location.
+// Returned times are the start of the day in the DefaultLoc location. Use
+// CalcIn to calculate the dates in a specific location.
 func (h *Holiday) Calc(year int) (actual, observed time.Time) {
+	return h.CalcIn(year, DefaultLoc)
+}
+
+// CalcIn reports the actual and observed dates of a holiday for the given
+// year. If the holiday is not observed in the given year, the zero time is
+// returned.
+//
+// Returned times are the start of the day in the given location.
+func (h *Holiday) CalcIn(year int, loc *time.Location) (actual, observed time.Time) {
 	if (h.StartYear > 0 && year < h.StartYear) ||
 		(h.EndYear > 0 && year > h.EndYear) || h.Func == nil {
 		return time.Time{}, time.Time{}
@@ -118,11 +233,18 @@ func (h *Holiday) Calc(year int) (actual, observed time.Time) {
 			}
 		}
 	}
-	actual = h.Func(h, year)
+	actual = h.Func(h, year, loc)
 	if h.CalcOffset != 0 {
 		actual = actual.AddDate(0, 0, h.CalcOffset)
 	}
 
+	if h.ObservedFunc != nil {
+		if actual.IsZero() {
+			return actual, actual
+		}
+		return actual, h.ObservedFunc(h, actual)
+	}
+
 	if h.Observed == nil {
 		return actual, actual
 	}
@@ -138,25 +260,25 @@ func (h *Holiday) Calc(year int) (actual, observed time.Time) {
 
 // CalcDayOfMonth calculates the occurrence of a holiday that is always a
 // specific day of the month such as the 5th of November.
-func CalcDayOfMonth(h *Holiday, year int) time.Time {
-	return time.Date(year, h.Month, h.Day, 0, 0, 0, 0, DefaultLoc)
+func CalcDayOfMonth(h *Holiday, year int, loc *time.Location) time.Time {
+	return DayStart(time.Date(year, h.Month, h.Day, 12, 0, 0, 0, loc))
 }
 
 // CalcWeekdayOffset calculates the occurrence of a holiday that falls on the
 // nth occurrence of a weekday in a month, such as the third wednesday of July.
-func CalcWeekdayOffset(h *Holiday, year int) time.Time {
-	return DayStart(WeekdayN(year, h.Month, h.Weekday, h.Offset))
+func CalcWeekdayOffset(h *Holiday, year int, loc *time.Location) time.Time {
+	return DayStart(WeekdayNIn(year, h.Month, h.Weekday, h.Offset, loc))
 }
 
 // CalcWeekdayFrom calculates the occurrence of a holiday that falls on a
 // specific day of the week following a starting date.
-func CalcWeekdayFrom(h *Holiday, year int) time.Time {
-	return DayStart(WeekdayNFrom(time.Date(year, h.Month, h.Day, 12, 0, 0, 0, DefaultLoc), h.Weekday, h.Offset))
+func CalcWeekdayFrom(h *Holiday, year int, loc *time.Location) time.Time {
+	return DayStart(WeekdayNFrom(time.Date(year, h.Month, h.Day, 12, 0, 0, 0, loc), h.Weekday, h.Offset))
 }
 
 // CalcEasterOffset calculates the occurrence of a holiday that is determined
 // by its relation to the Easter holiday.
-func CalcEasterOffset(h *Holiday, year int) time.Time {
+func CalcEasterOffset(h *Holiday, year int, loc *time.Location) time.Time {
 	var month, day int
 	if h.Julian {
 		// Meeus algorithm
@@ -189,5 +311,5 @@ func CalcEasterOffset(h *Holiday, year int) time.Time {
 		day = ((h + l - 7*m + 114) % 31) + 1
 	}
 
-	return time.Date(year, time.Month(month), day+h.Offset, 0, 0, 0, 0, DefaultLoc)
+	return DayStart(time.Date(year, time.Month(month), day+h.Offset, 12, 0, 0, 0, loc))
 }
@@ -35,23 +35,87 @@ func TestClone(t *testing.T) {
 	}
 
 	c = h.Clone(&Holiday{
-		Name:        "clone",
-		Description: "clone desc",
-		Type:        ObservanceBank,
-		StartYear:   1234,
-		EndYear:     2345,
-		Except:      []int{1, 2, 3, 4},
-		Observed:    []AltDay{{Day: 1, Offset: 2}},
+		Name:         "clone",
+		Description:  "clone desc",
+		Type:         ObservanceBank,
+		StartYear:    1234,
+		EndYear:      2345,
+		Except:       []int{1, 2, 3, 4},
+		Observed:     []AltDay{{Day: 1, Offset: 2}},
+		ObservedFunc: func(h *Holiday, actual time.Time) time.Time { return actual },
 	})
 
 	if c.Day != h.Day || c.Description != "clone desc" || c.EndYear != 2345 ||
 		reflect.DeepEqual(c.Except, h.Except) || c.Julian != h.Julian ||
 		c.Month != h.Month || c.Name != "clone" || reflect.DeepEqual(c.Observed, h.Observed) || c.Offset != h.Offset ||
-		c.StartYear != 1234 || c.Type != ObservanceBank || c.Weekday != h.Weekday {
+		c.StartYear != 1234 || c.Type != ObservanceBank || c.Weekday != h.Weekday || c.ObservedFunc == nil {
 
 		t.Errorf("bad partial clone")
 	}
 
+	h.Names = map[string]string{"en": "New Year's Day", "de": "Neujahr"}
+	c = h.Clone(&Holiday{Names: map[string]string{"de": "Neujahrstag", "fr": "Nouvel An"}})
+	want := map[string]string{"en": "New Year's Day", "de": "Neujahrstag", "fr": "Nouvel An"}
+	if !reflect.DeepEqual(c.Names, want) || h.Names["de"] != "Neujahr" {
+		t.Errorf("bad names clone: %v", c.Names)
+	}
+	c.Names["en"] = "changed"
+	if h.Clone(nil).Names["en"] != "New Year's Day" || h.Names["en"] != "New Year's Day" {
+		t.Errorf("names shared with clone")
+	}
+
+	h.ID = "aa/new-year"
+	h.Subdivisions = []string{"XX-AA"}
+	h.Source = "Act 1"
+	c = h.Clone(&Holiday{Name: "renamed"})
+	if c.ID != "aa/new-year" || c.BaseID != "" || !reflect.DeepEqual(c.Subdivisions, h.Subdivisions) || c.Source != "Act 1" {
+		t.Errorf("bad metadata clone: %s, %s, %v, %s", c.ID, c.BaseID, c.Subdivisions, c.Source)
+	}
+	c = h.Clone(&Holiday{ID: "xx/new-year", Subdivisions: []string{"XX-BB"}, Source: "Act 2"})
+	if c.ID != "xx/new-year" || c.BaseID != "aa/new-year" || c.Subdivisions[0] != "XX-BB" || c.Source != "Act 2" {
+		t.Errorf("bad metadata override: %s, %s, %v, %s", c.ID, c.BaseID, c.Subdivisions, c.Source)
+	}
+	c = c.Clone(&Holiday{ID: "xx/other"})
+	if c.ID != "xx/other" || c.BaseID != "aa/new-year" {
+		t.Errorf("bad base ID: %s, %s", c.ID, c.BaseID)
+	}
+}
+
+func TestNameIn(t *testing.T) {
+	h := &Holiday{
+		Name: "Neujahrstag",
+		Names: map[string]string{
+			"de":    "Neujahr",
+			"de-CH": "Neujahrstag",
+			"en":    "New Year's Day",
+			"fr-CH": "Nouvel An",
+			"fr-BE": "Jour de l'an",
+		},
+	}
+
+	tests := []struct {
+		tags []string
+		want string
+	}{
+		{nil, "Neujahrstag"},
+		{[]string{"en"}, "New Year's Day"},
+		{[]string{"EN-us"}, "New Year's Day"},
+		{[]string{"de"}, "Neujahr"},
+		{[]string{"de-AT"}, "Neujahr"},
+		{[]string{"de_ch"}, "Neujahrstag"},
+		{[]string{"fr-CH"}, "Nouvel An"},
+		{[]string{"fr"}, "Jour de l'an"},
+		{[]string{"fr-CA"}, "Jour de l'an"},
+		{[]string{"it", "fr-CH", "en"}, "Nouvel An"},
+		{[]string{"it"}, "Neujahrstag"},
+		{[]string{""}, "Neujahrstag"},
+	}
+
+	for i, test := range tests {
+		if got := h.NameIn(test.tags...); got != test.want {
+			t.Errorf("[%d] got: %q; want: %q", i, got, test.want)
+		}
+	}
 }
 
 func TestCalc(t *testing.T) {
@@ -66,7 +130,9 @@ func TestCalc(t *testing.T) {
 		StartYear: 2015,
 		EndYear:   2025,
 		Except:    []int{2020},
-		Func:      func(h *Holiday, year int) time.Time { return time.Date(year, time.March, 11, 0, 0, 0, 0, DefaultLoc) },
+		Func: func(h *Holiday, year int, loc *time.Location) time.Time {
+			return time.Date(year, time.March, 11, 0, 0, 0, 0, loc)
+		},
 	}
 
 	h3 := &Holiday{
@@ -112,6 +178,61 @@ func TestCalc(t *testing.T) {
 			time.Date(2016, time.March, 12, 0, 0, 0, 0, DefaultLoc),
 			time.Date(2016, time.March, 12, 0, 0, 0, 0, DefaultLoc))
 	}
+
+	h2.ObservedFunc = func(h *Holiday, actual time.Time) time.Time { return actual.AddDate(0, 0, 7) }
+	act, obs = h2.Calc(2016)
+	if !act.Equal(time.Date(2016, time.March, 11, 0, 0, 0, 0, DefaultLoc)) ||
+		!obs.Equal(time.Date(2016, time.March, 18, 0, 0, 0, 0, DefaultLoc)) {
+		t.Errorf("observed func 2016 - got: %s, %s; want: %s, %s", act, obs,
+			time.Date(2016, time.March, 11, 0, 0, 0, 0, DefaultLoc),
+			time.Date(2016, time.March, 18, 0, 0, 0, 0, DefaultLoc))
+	}
+	act, obs = h2.Calc(2020)
+	if !act.IsZero() || !obs.IsZero() {
+		t.Errorf("expected zero time with observed func in exception year")
+	}
+
+	h4 := &Holiday{
+		Func:         func(h *Holiday, year int, loc *time.Location) time.Time { return time.Time{} },
+		ObservedFunc: h2.ObservedFunc,
+	}
+	act, obs = h4.Calc(2016)
+	if !act.IsZero() || !obs.IsZero() {
+		t.Errorf("expected zero time with observed func when holiday doesn't occur")
+	}
+}
+
+func TestCalcIn(t *testing.T) {
+	zone1 := time.FixedZone("test1", -10*60*60)
+	zone2 := time.FixedZone("test2", 9*60*60)
+
+	tests := []struct {
+		h    *Holiday
+		loc  *time.Location
+		want time.Time
+	}{
+		{&Holiday{Month: time.May, Day: 1, Func: CalcDayOfMonth}, time.UTC,
+			time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{&Holiday{Month: time.May, Day: 1, Func: CalcDayOfMonth}, zone1,
+			time.Date(2021, 5, 1, 0, 0, 0, 0, zone1)},
+		{&Holiday{Month: time.May, Day: 1, Func: CalcDayOfMonth}, zone2,
+			time.Date(2021, 5, 1, 0, 0, 0, 0, zone2)},
+		{&Holiday{Month: time.May, Weekday: time.Monday, Offset: -1, Func: CalcWeekdayOffset}, zone1,
+			time.Date(2021, 5, 31, 0, 0, 0, 0, zone1)},
+		{&Holiday{Month: time.May, Weekday: time.Monday, Offset: -1, Func: CalcWeekdayOffset}, zone2,
+			time.Date(2021, 5, 31, 0, 0, 0, 0, zone2)},
+		{&Holiday{Month: time.May, Day: 1, Weekday: time.Monday, Offset: 1, Func: CalcWeekdayFrom}, zone2,
+			time.Date(2021, 5, 3, 0, 0, 0, 0, zone2)},
+		{&Holiday{Offset: 1, Func: CalcEasterOffset}, zone1,
+			time.Date(2021, 4, 5, 0, 0, 0, 0, zone1)},
+	}
+
+	for i, test := range tests {
+		act, obs := test.h.CalcIn(2021, test.loc)
+		if !act.Equal(test.want) || !obs.Equal(test.want) || act.Location() != test.loc {
+			t.Errorf("[%d] got: %s, %s; want: %s", i, act, obs, test.want)
+		}
+	}
 }
 
 func TestCalcDayOfMonth(t *testing.T) {
@@ -133,7 +254,7 @@ func TestCalcDayOfMonth(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		got := CalcDayOfMonth(h, test.y)
+		got := CalcDayOfMonth(h, test.y, DefaultLoc)
 		if !got.Equal(test.want) {
 			t.Errorf("%d: got: %s, want: %s", test.y, got, test.want)
 		}
@@ -165,7 +286,7 @@ func TestCalcWeekdayOffset(t *testing.T) {
 
 	for _, test := range tests {
 		h.Offset = test.off
-		got := CalcWeekdayOffset(h, 2015)
+		got := CalcWeekdayOffset(h, 2015, DefaultLoc)
 		if !got.Equal(test.want) {
 			t.Errorf("%d: got: %s, want: %s", test.off, got, test.want)
 		}
@@ -198,7 +319,7 @@ func TestCalcWeekdayFrom(t *testing.T) {
 
 	for _, test := range tests {
 		h.Offset = test.off
-		got := CalcWeekdayFrom(h, 2015)
+		got := CalcWeekdayFrom(h, 2015, DefaultLoc)
 		if !got.Equal(test.want) {
 			t.Errorf("%d: got: %s, want: %s", test.off, got, test.want)
 		}
@@ -225,7 +346,7 @@ func TestCalcEasterOffset(t *testing.T) {
 	for _, test := range tests {
 		h.Offset = test.off
 		h.Julian = test.jul
-		got := CalcEasterOffset(h, test.y)
+		got := CalcEasterOffset(h, test.y, DefaultLoc)
 		if !got.Equal(test.want) {
 			t.Errorf("%d: got: %s, want: %s", test.off, got, test.want)
 		}
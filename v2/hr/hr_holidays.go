@@ -14,81 +14,147 @@ var (
 
 	// NovaGodina represents New Year's Day on 1-Jan
 	NovaGodina = &cal.Holiday{
-		Name:  "Nova godina",
-		Month: time.January,
-		Day:   1,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/nova-godina",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Nova godina",
+		Names:  map[string]string{"hr": "Nova godina", "en": "New Year's Day"},
+		Month:  time.January,
+		Day:    1,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// SvetaTriKralja represents Epiphany on 6-Jan
 	SvetaTriKralja = &cal.Holiday{
-		Name:  "Sveta tri kralja",
-		Month: time.January,
-		Day:   6,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/sveta-tri-kralja",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Sveta tri kralja",
+		Names:  map[string]string{"hr": "Sveta tri kralja", "en": "Epiphany"},
+		Month:  time.January,
+		Day:    6,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// Uskrs represents Easter
-	Uskrs = aa.Easter.Clone(&cal.Holiday{Name: "Uskrs", Type: cal.ObservancePublic})
+	Uskrs = aa.Easter.Clone(&cal.Holiday{
+		ID:     "hr/uskrs",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Uskrs",
+		Names:  map[string]string{"hr": "Uskrs", "en": "Easter Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// UskrsnjiPonedjeljak represents Easter Monday
-	UskrsnjiPonedjeljak = aa.EasterMonday.Clone(&cal.Holiday{Name: "Uskrsni ponedjeljak", Type: cal.ObservancePublic})
+	UskrsnjiPonedjeljak = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "hr/uskrsnji-ponedjeljak",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Uskrsni ponedjeljak",
+		Names:  map[string]string{"hr": "Uskrsni ponedjeljak", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// PraznikRada represents Workers day on 1-May
-	PraznikRada = aa.WorkersDay.Clone(&cal.Holiday{Name: "Praznik rada", Type: cal.ObservancePublic})
+	PraznikRada = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "hr/praznik-rada",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Praznik rada",
+		Names:  map[string]string{"hr": "Praznik rada", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanDrzavnosti represents National day on 30-May
 	DanDrzavnosti = &cal.Holiday{
-		Name:  "Dan državnosti",
-		Month: time.May,
-		Day:   30,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/dan-drzavnosti",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Dan državnosti",
+		Names:  map[string]string{"hr": "Dan državnosti", "en": "Statehood Day"},
+		Month:  time.May,
+		Day:    30,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// Tijelovo represents Corpus Christi
-	Tijelovo = aa.CorpusChristi.Clone(&cal.Holiday{Name: "Tijelovo", Type: cal.ObservancePublic})
+	Tijelovo = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:     "hr/tijelovo",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Tijelovo",
+		Names:  map[string]string{"hr": "Tijelovo", "en": "Corpus Christi"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanAntifasistickeBorbe represents Anti-Fascist Struggle day on 22-June
 	DanAntifasistickeBorbe = &cal.Holiday{
-		Name:  "Dan antifašističke borbe",
-		Month: time.June,
-		Day:   22,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/dan-antifasisticke-borbe",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Dan antifašističke borbe",
+		Names:  map[string]string{"hr": "Dan antifašističke borbe", "en": "Anti-Fascist Struggle Day"},
+		Month:  time.June,
+		Day:    22,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// DanPobjedeIDomovinskeZahvalnosti represents Victory and Homeland Thanksgiving day on 5-August
 	DanPobjedeIDomovinskeZahvalnosti = &cal.Holiday{
-		Name:  "Dan pobjede i domovinske zahvalnosti",
-		Month: time.August,
-		Day:   5,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/dan-pobjede-i-domovinske-zahvalnosti",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Dan pobjede i domovinske zahvalnosti",
+		Names:  map[string]string{"hr": "Dan pobjede i domovinske zahvalnosti", "en": "Victory and Homeland Thanksgiving Day"},
+		Month:  time.August,
+		Day:    5,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// VelikaGospa represents Assumption of Mary on 1-August
-	VelikaGospa = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Velika Gospa", Type: cal.ObservancePublic})
+	VelikaGospa = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "hr/velika-gospa",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Velika Gospa",
+		Names:  map[string]string{"hr": "Velika Gospa", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanSvihSvetih represents AllSaints Day on 1-November
-	DanSvihSvetih = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Dan svih svetih", Type: cal.ObservancePublic})
+	DanSvihSvetih = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "hr/dan-svih-svetih",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Dan svih svetih",
+		Names:  map[string]string{"hr": "Dan svih svetih", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanSjecanjaNaZrtveDomovinskogRata represents Day of Remembrance of the Victims of the Homeland War on 18-November
 	DanSjecanjaNaZrtveDomovinskogRata = &cal.Holiday{
-		Name:  "Dan sjećanja na žrtve Domovinskog rata",
-		Month: time.November,
-		Day:   18,
-		Func:  cal.CalcDayOfMonth,
-		Type:  cal.ObservancePublic,
+		ID:     "hr/dan-sjecanja-na-zrtve-domovinskog-rata",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Dan sjećanja na žrtve Domovinskog rata",
+		Names:  map[string]string{"hr": "Dan sjećanja na žrtve Domovinskog rata", "en": "Remembrance Day for the Victims of the Homeland War"},
+		Month:  time.November,
+		Day:    18,
+		Func:   cal.CalcDayOfMonth,
+		Type:   cal.ObservancePublic,
 	}
 
 	// Bozic represents Christmas Day on 25-December
-	Bozic = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Božić", Type: cal.ObservancePublic})
+	Bozic = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "hr/bozic",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Božić",
+		Names:  map[string]string{"hr": "Božić", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SvetiStjepan represents Saint Stephen's Day on 25-December
-	SvetiStjepan = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Sveti Stjepan", Type: cal.ObservancePublic})
+	SvetiStjepan = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "hr/sveti-stjepan",
+		Source: "Zakon o blagdanima, spomendanima i neradnim danima u Republici Hrvatskoj (NN 110/19)",
+		Name:   "Sveti Stjepan",
+		Names:  map[string]string{"hr": "Sveti Stjepan", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -108,3 +174,12 @@ var (
 		SvetiStjepan,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "HR",
+		Alpha3:   "HRV",
+		Name:     "Croatia",
+		Holidays: Holidays,
+	})
+}
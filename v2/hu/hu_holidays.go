@@ -12,60 +12,114 @@ import (
 
 var (
 	// Ujev represents New Year's Day on 1-Jan
-	Ujev = aa.NewYear.Clone(&cal.Holiday{Name: "Újév", Type: cal.ObservancePublic})
+	Ujev = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "hu/ujev",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Újév",
+		Names:  map[string]string{"hu": "Újév", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NemzetiUnnepMarcius represents Revolution Day on 15-Mar
 	NemzetiUnnepMarcius = &cal.Holiday{
-		Name:  "Nemzeti ünnep",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   15,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "hu/nemzeti-unnep-marcius",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Nemzeti ünnep",
+		Names:  map[string]string{"hu": "Nemzeti ünnep", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.March,
+		Day:    15,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Nagypentek represents Good Friday (movable, Friday before Easter Sunday)
-	Nagypentek = aa.GoodFriday.Clone(&cal.Holiday{Name: "Nagypéntek", Type: cal.ObservancePublic})
+	Nagypentek = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "hu/nagypentek",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Nagypéntek",
+		Names:  map[string]string{"hu": "Nagypéntek", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// HusvetHetfo represents Easter Monday (movable, Monday after Easter Sunday)
-	HusvetHetfo = aa.EasterMonday.Clone(&cal.Holiday{Name: "Húsvéthétfő", Type: cal.ObservancePublic})
+	HusvetHetfo = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "hu/husvet-hetfo",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Húsvéthétfő",
+		Names:  map[string]string{"hu": "Húsvéthétfő", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AmunkaUnnepe represents Labour Day on 1-May
-	AmunkaUnnepe = aa.WorkersDay.Clone(&cal.Holiday{Name: "A munka ünnepe", Type: cal.ObservancePublic})
+	AmunkaUnnepe = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "hu/amunka-unnepe",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "A munka ünnepe",
+		Names:  map[string]string{"hu": "A munka ünnepe", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// PunkosdHetfo represents Whit Monday (movable, Monday after Pentecost)
-	PunkosdHetfo = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Pünkösdhétfő", Type: cal.ObservancePublic})
+	PunkosdHetfo = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "hu/punkosd-hetfo",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Pünkösdhétfő",
+		Names:  map[string]string{"hu": "Pünkösdhétfő", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SzentIstvanUnnepe represents State Foundation Day on 20-Aug
 	SzentIstvanUnnepe = &cal.Holiday{
-		Name:  "Az államalapítás ünnepe",
-		Type:  cal.ObservancePublic,
-		Month: time.August,
-		Day:   20,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "hu/szent-istvan-unnepe",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Az államalapítás ünnepe",
+		Names:  map[string]string{"hu": "Az államalapítás ünnepe", "en": "State Foundation Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.August,
+		Day:    20,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// NemzetiUnnepOkt represents Republic Day on 23-Oct
 	NemzetiUnnepOkt = &cal.Holiday{
-		Name:  "Nemzeti ünnep",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   23,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "hu/nemzeti-unnep-okt",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Nemzeti ünnep",
+		Names:  map[string]string{"hu": "Nemzeti ünnep", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    23,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Mindenszentek represents All Saints' Day on 1-Nov
-	Mindenszentek = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Mindenszentek", Type: cal.ObservancePublic})
+	Mindenszentek = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "hu/mindenszentek",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Mindenszentek",
+		Names:  map[string]string{"hu": "Mindenszentek", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Karacsony represents Christmas Day on 25-Dec
-	Karacsony = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Karácsony", Type: cal.ObservancePublic})
+	Karacsony = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "hu/karacsony",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Karácsony",
+		Names:  map[string]string{"hu": "Karácsony", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// KaracsonyMasnapja represents Second Day of Christmas on 26-Dec
 	KaracsonyMasnapja = &cal.Holiday{
-		Name:  "Karácsony másnapja",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   26,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "hu/karacsony-masnapja",
+		Source: "2012. évi I. törvény (Munka törvénykönyve) 102. §",
+		Name:   "Karácsony másnapja",
+		Names:  map[string]string{"hu": "Karácsony másnapja", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    26,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -83,3 +137,12 @@ var (
 		KaracsonyMasnapja,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "HU",
+		Alpha3:   "HUN",
+		Name:     "Hungary",
+		Holidays: Holidays,
+	})
+}
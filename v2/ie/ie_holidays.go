@@ -12,11 +12,18 @@ import (
 
 var (
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "ie/new-year",
+		Source: "Organisation of Working Time Act 1997, Sch. 2",
+		Names:  map[string]string{"ga": "Lá Caille"},
+	})
 
 	// SaintBrigidDay represents Saint Patrick's Day on 17-Mar
 	SaintBrigidDay = &cal.Holiday{
+		ID:        "ie/saint-brigid-day",
+		Source:    "Organisation of Working Time Act 1997, Sch. 2",
 		Name:      "Saint Brigid’s Day",
+		Names:     map[string]string{"en": "Saint Brigid’s Day", "ga": "Lá Fhéile Bríde"},
 		Month:     time.February,
 		Weekday:   time.Monday,
 		Offset:    1,
@@ -26,7 +33,10 @@ var (
 
 	// ExtraPublicHoliday2022 represents extra public holiday in 2022
 	ExtraPublicHoliday2022 = &cal.Holiday{
+		ID:        "ie/extra-public-holiday-2022",
+		Source:    "Organisation of Working Time Act 1997, Sch. 2",
 		Name:      "Extra Public Holiday 2022",
+		Names:     map[string]string{"en": "Extra Public Holiday 2022", "ga": "Lá Saoire Poiblí Breise 2022"},
 		Month:     time.March,
 		Day:       18,
 		StartYear: 2022,
@@ -36,18 +46,28 @@ var (
 
 	// SaintPatrickDay represents Saint Patrick's Day on 17-Mar
 	SaintPatrickDay = &cal.Holiday{
-		Name:  "Saint Patrick's Day",
-		Month: time.March,
-		Day:   17,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ie/saint-patrick-day",
+		Source: "Organisation of Working Time Act 1997, Sch. 2",
+		Name:   "Saint Patrick's Day",
+		Names:  map[string]string{"en": "Saint Patrick's Day", "ga": "Lá Fhéile Pádraig"},
+		Month:  time.March,
+		Day:    17,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "ie/easter-monday",
+		Source: "Organisation of Working Time Act 1997, Sch. 2",
+		Names:  map[string]string{"ga": "Luan Cásca"},
+	})
 
 	// FirstMondayMay represents the first Monday in May
 	FirstMondayMay = &cal.Holiday{
+		ID:      "ie/first-monday-may",
+		Source:  "Organisation of Working Time Act 1997, Sch. 2",
 		Name:    "First Monday in May",
+		Names:   map[string]string{"en": "First Monday in May", "ga": "Lá Saoire i mí na Bealtaine"},
 		Month:   time.May,
 		Weekday: time.Monday,
 		Offset:  1,
@@ -56,7 +76,10 @@ var (
 
 	// FirstMondayJune represents the first Monday in June
 	FirstMondayJune = &cal.Holiday{
+		ID:      "ie/first-monday-june",
+		Source:  "Organisation of Working Time Act 1997, Sch. 2",
 		Name:    "First Monday in June",
+		Names:   map[string]string{"en": "First Monday in June", "ga": "Lá Saoire i mí an Mheithimh"},
 		Month:   time.June,
 		Weekday: time.Monday,
 		Offset:  1,
@@ -65,7 +88,10 @@ var (
 
 	// FirstMondayAugust represents the first Monday in August
 	FirstMondayAugust = &cal.Holiday{
+		ID:      "ie/first-monday-august",
+		Source:  "Organisation of Working Time Act 1997, Sch. 2",
 		Name:    "First Monday in August",
+		Names:   map[string]string{"en": "First Monday in August", "ga": "Lá Saoire i mí Lúnasa"},
 		Month:   time.August,
 		Weekday: time.Monday,
 		Offset:  1,
@@ -74,7 +100,10 @@ var (
 
 	// LastMondayInOctober represents the last Monday in October
 	LastMondayInOctober = &cal.Holiday{
+		ID:      "ie/last-monday-in-october",
+		Source:  "Organisation of Working Time Act 1997, Sch. 2",
 		Name:    "Last Monday in October",
+		Names:   map[string]string{"en": "Last Monday in October", "ga": "Lá Saoire i mí Dheireadh Fómhair"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Weekday: time.Monday,
@@ -83,10 +112,19 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "ie/christmas-day",
+		Source: "Organisation of Working Time Act 1997, Sch. 2",
+		Names:  map[string]string{"ga": "Lá Nollag"},
+	})
 
 	// SaintStephenDay represents Saint Stephen's Day on 26-Dec
-	SaintStephenDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Saint Stephen's Day"})
+	SaintStephenDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "ie/saint-stephen-day",
+		Source: "Organisation of Working Time Act 1997, Sch. 2",
+		Name:   "Saint Stephen's Day",
+		Names:  map[string]string{"en": "Saint Stephen's Day", "ga": "Lá Fhéile Stiofáin"},
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -104,12 +142,21 @@ var (
 	}
 )
 
-func CalcIfFirstFallsOnFriday(h *cal.Holiday, year int) time.Time {
-	fistFriday := cal.DayStart(cal.WeekdayN(year, h.Month, time.Friday, 1))
+func CalcIfFirstFallsOnFriday(h *cal.Holiday, year int, loc *time.Location) time.Time {
+	fistFriday := cal.DayStart(cal.WeekdayNIn(year, h.Month, time.Friday, 1, loc))
 	// if 1st falls on a Friday
 	if fistFriday.Day() == 1 {
 		return fistFriday
 	}
 
-	return cal.DayStart(cal.WeekdayN(year, h.Month, h.Weekday, h.Offset))
+	return cal.DayStart(cal.WeekdayNIn(year, h.Month, h.Weekday, h.Offset, loc))
+}
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "IE",
+		Alpha3:   "IRL",
+		Name:     "Ireland",
+		Holidays: Holidays,
+	})
 }
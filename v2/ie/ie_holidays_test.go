@@ -73,3 +73,23 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		h    *cal.Holiday
+		tag  string
+		want string
+	}{
+		{NewYear, "ga", "Lá Caille"},
+		{EasterMonday, "ga-IE", "Luan Cásca"},
+		{ChristmasDay, "ga", "Lá Nollag"},
+		{ChristmasDay, "en-IE", "Christmas Day"},
+		{SaintStephenDay, "ga", "Lá Fhéile Stiofáin"},
+	}
+
+	for _, test := range tests {
+		if got := test.h.NameIn(test.tag); got != test.want {
+			t.Errorf("%s %s: got: %q; want: %q", test.h.Name, test.tag, got, test.want)
+		}
+	}
+}
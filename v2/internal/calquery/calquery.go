@@ -0,0 +1,131 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Package calquery holds the parsing and calendar selection shared by the
+// cal command and the calhttp package.
+package calquery
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rickar/cal/v2"
+)
+
+// Layouts of dates and times in arguments and results.
+const (
+	DateLayout = "2006-01-02"                // layout of dates
+	TimeLayout = "2006-01-02T15:04:05Z07:00" // layout of times with an offset
+)
+
+// timeLayouts are the layouts accepted by ParseTime.
+var timeLayouts = []string{TimeLayout, "2006-01-02T15:04:05", "2006-01-02T15:04", DateLayout}
+
+// ParseDate parses a date such as 2026-12-24 in the given location.
+func ParseDate(s string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(DateLayout, s, loc)
+}
+
+// ParseTime parses a date or a date and time such as 2026-12-24T09:30 in the
+// given location. Times with an offset, such as 2026-12-24T09:30:00+01:00,
+// keep their own offset.
+func ParseTime(s string, loc *time.Location) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseHours parses working hours such as "09:00-17:00". An end before the
+// start, as in "22:00-06:00", is an overnight shift that ends on the next day.
+// The end may be 24:00; hours with the same start and end are rejected.
+func ParseHours(s string) (start, end time.Duration, ok bool) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	var err1, err2 error
+	start, err1 = ParseClock(parts[0])
+	end, err2 = ParseClock(parts[1])
+	return start, end, err1 == nil && err2 == nil && start != end
+}
+
+// FormatHours formats working hours in the form accepted by ParseHours.
+func FormatHours(start, end time.Duration) string {
+	return FormatClock(start) + "-" + FormatClock(end)
+}
+
+// ParseClock parses hours and minutes such as "09:30" into a duration since
+// midnight. Times up to 24:00 are allowed.
+func ParseClock(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, err
+}
+
+// FormatClock formats a duration since midnight as hours and minutes.
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+var typeNames = map[cal.ObservanceType]string{
+	cal.ObservanceUnknown:   "unknown",
+	cal.ObservancePublic:    "public",
+	cal.ObservanceBank:      "bank",
+	cal.ObservanceReligious: "religious",
+	cal.ObservanceOther:     "other",
+}
+
+// TypeName reports the name of an observance type, such as "public".
+func TypeName(t cal.ObservanceType) string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return typeNames[cal.ObservanceUnknown]
+}
+
+// SplitCodes splits calendar codes separated by commas, such as "us,ecb".
+// "+" and spaces are also accepted as separators, since "+" in a URL query
+// decodes to a space.
+func SplitCodes(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == '+' || r == ',' || r == ' '
+	})
+}
+
+// UnknownCalendarError is returned by Calendar for a code that lookup doesn't
+// know.
+type UnknownCalendarError struct {
+	Code string // the unknown code
+}
+
+func (e *UnknownCalendarError) Error() string {
+	return fmt.Sprintf("unknown calendar %q", e.Code)
+}
+
+// Calendar creates the calendar for the given codes with the given working
+// hours. Each code is looked up with lookup, or cal.NewBusinessCalendarFor if
+// it is nil. Several codes are combined so that a day is only a workday if it
+// is a workday in all of them.
+func Calendar(codes []string, lookup func(code string) *cal.BusinessCalendar, start, end time.Duration) (cal.WorkCalendar, error) {
+	if lookup == nil {
+		lookup = cal.NewBusinessCalendarFor
+	}
+	var members []cal.WorkCalendar
+	for _, code := range codes {
+		c := lookup(code)
+		if c == nil {
+			return nil, &UnknownCalendarError{code}
+		}
+		c.SetWorkHours(start, end)
+		members = append(members, c)
+	}
+	if len(members) == 1 {
+		return members[0], nil
+	}
+	return cal.NewCompositeCalendar(cal.JointOpen, members...), nil
+}
@@ -0,0 +1,137 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package calquery
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal/v2"
+)
+
+func TestParseTime(t *testing.T) {
+	loc, _ := time.LoadLocation("Europe/Berlin")
+	tests := []struct {
+		s    string
+		want time.Time
+		ok   bool
+	}{
+		{"2026-12-24", time.Date(2026, 12, 24, 0, 0, 0, 0, loc), true},
+		{"2026-12-24T09:30", time.Date(2026, 12, 24, 9, 30, 0, 0, loc), true},
+		{"2026-12-24T09:30:15", time.Date(2026, 12, 24, 9, 30, 15, 0, loc), true},
+		{"2026-12-24T09:30:00Z", time.Date(2026, 12, 24, 9, 30, 0, 0, time.UTC), true},
+		{"24.12.2026", time.Time{}, false},
+	}
+	for _, test := range tests {
+		got, ok := ParseTime(test.s, loc)
+		if ok != test.ok || !got.Equal(test.want) {
+			t.Errorf("%s: got: %s, %t; want: %s, %t", test.s, got, ok, test.want, test.ok)
+		}
+	}
+
+	if got, err := ParseDate("2026-12-24", loc); err != nil || !got.Equal(time.Date(2026, 12, 24, 0, 0, 0, 0, loc)) {
+		t.Errorf("got: %s, %v", got, err)
+	}
+	if _, err := ParseDate("2026-12-24T09:30", loc); err == nil {
+		t.Errorf("got no error for a time")
+	}
+}
+
+func TestParseHours(t *testing.T) {
+	tests := []struct {
+		s     string
+		start time.Duration
+		end   time.Duration
+		ok    bool
+	}{
+		{"09:00-17:00", 9 * time.Hour, 17 * time.Hour, true},
+		{"08:30-12:15", 8*time.Hour + 30*time.Minute, 12*time.Hour + 15*time.Minute, true},
+		{"00:00-24:00", 0, 24 * time.Hour, true},
+		{"22:00-06:00", 22 * time.Hour, 6 * time.Hour, true},
+		{"09:00-09:00", 9 * time.Hour, 9 * time.Hour, false},
+		{"09:00", 0, 0, false},
+		{"9-5", 0, 0, false},
+		{"09:00-5pm", 9 * time.Hour, 0, false},
+	}
+	for _, test := range tests {
+		start, end, ok := ParseHours(test.s)
+		if ok != test.ok || start != test.start || end != test.end {
+			t.Errorf("%s: got: %s, %s, %t; want: %s, %s, %t", test.s, start, end, ok, test.start, test.end, test.ok)
+		}
+		if ok {
+			if got := FormatHours(start, end); got != test.s {
+				t.Errorf("got: %s; want: %s", got, test.s)
+			}
+		}
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	tests := []struct {
+		t    cal.ObservanceType
+		want string
+	}{
+		{cal.ObservanceUnknown, "unknown"},
+		{cal.ObservancePublic, "public"},
+		{cal.ObservanceBank, "bank"},
+		{cal.ObservanceReligious, "religious"},
+		{cal.ObservanceOther, "other"},
+		{cal.ObservanceType(99), "unknown"},
+	}
+	for _, test := range tests {
+		if got := TypeName(test.t); got != test.want {
+			t.Errorf("%d: got: %s; want: %s", test.t, got, test.want)
+		}
+	}
+}
+
+func TestSplitCodes(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", []string{}},
+		{"gb", []string{"gb"}},
+		{"us+ecb", []string{"us", "ecb"}},
+		{"us, ecb DE-BY", []string{"us", "ecb", "DE-BY"}},
+	}
+	for _, test := range tests {
+		if got := SplitCodes(test.s); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got: %q; want: %q", test.s, got, test.want)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	hol := &cal.Holiday{Month: time.June, Day: 5, Func: cal.CalcDayOfMonth}
+	lookup := func(code string) *cal.BusinessCalendar {
+		c := cal.NewBusinessCalendar()
+		switch code {
+		case "a":
+		case "b":
+			c.AddHoliday(hol)
+		default:
+			return nil
+		}
+		return c
+	}
+	day := time.Date(2026, 6, 5, 12, 0, 0, 0, time.UTC)
+
+	c, err := Calendar([]string{"a"}, lookup, 8*time.Hour, 12*time.Hour)
+	if err != nil || !c.IsWorkday(day) || c.WorkHoursInRange(day.Add(-12*time.Hour), day.Add(12*time.Hour)) != 4*time.Hour {
+		t.Errorf("single: got: %v, %v", c, err)
+	}
+	c, err = Calendar([]string{"a", "b"}, lookup, 8*time.Hour, 12*time.Hour)
+	if err != nil || c.IsWorkday(day) {
+		t.Errorf("composite: got: %v, %v", c, err)
+	}
+	_, err = Calendar([]string{"a", "x"}, lookup, 8*time.Hour, 12*time.Hour)
+	if e, ok := err.(*UnknownCalendarError); !ok || e.Code != "x" || err.Error() != `unknown calendar "x"` {
+		t.Errorf("unknown: got: %v", err)
+	}
+	_, err = Calendar([]string{"zz-nowhere"}, nil, 8*time.Hour, 12*time.Hour)
+	if _, ok := err.(*UnknownCalendarError); !ok {
+		t.Errorf("default lookup: got: %v", err)
+	}
+}
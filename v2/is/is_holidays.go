@@ -9,20 +9,47 @@ import (
 
 var (
 	// Nyarsdagur represents New Year's Day on 1-Jan
-	Nyarsdagur = aa.NewYear.Clone(&cal.Holiday{Name: "Nýársdagur", Type: cal.ObservancePublic})
+	Nyarsdagur = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "is/nyarsdagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Nýársdagur",
+		Names:  map[string]string{"is": "Nýársdagur", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Skirdagur represents Maundy Thursday on the Thursday before Easter
-	Skirdagur = aa.MaundyThursday.Clone(&cal.Holiday{Name: "Skírdagur", Type: cal.ObservancePublic})
+	Skirdagur = aa.MaundyThursday.Clone(&cal.Holiday{
+		ID:     "is/skirdagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Skírdagur",
+		Names:  map[string]string{"is": "Skírdagur", "en": "Maundy Thursday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Langifostudagur represents Good Friday on the Friday before Easter
-	Langifostudagur = aa.GoodFriday.Clone(&cal.Holiday{Name: "Föstudagurinn langi", Type: cal.ObservancePublic})
+	Langifostudagur = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "is/langifostudagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Föstudagurinn langi",
+		Names:  map[string]string{"is": "Föstudagurinn langi", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Annaripaskum represents Easter Monday on the day after Easter
-	Annaripaskum = aa.EasterMonday.Clone(&cal.Holiday{Name: "Annar í páskum", Type: cal.ObservancePublic})
+	Annaripaskum = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "is/annaripaskum",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Annar í páskum",
+		Names:  map[string]string{"is": "Annar í páskum", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Sumardagurinn represents the First Day of Summer on the first Thursday after 18-Apr
 	Sumardagurinn = &cal.Holiday{
+		ID:      "is/sumardagurinn",
+		Source:  "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
 		Name:    "Sumardagurinn fyrsti",
+		Names:   map[string]string{"is": "Sumardagurinn fyrsti", "en": "First Day of Summer"},
 		Type:    cal.ObservancePublic,
 		Month:   time.April,
 		Day:     19,
@@ -32,26 +59,50 @@ var (
 	}
 
 	// Verkalydsdagurinn represents Labour Day on 1-May
-	Verkalydsdagurinn = aa.WorkersDay.Clone(&cal.Holiday{Name: "Verkalýðsdagurinn", Type: cal.ObservancePublic})
+	Verkalydsdagurinn = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "is/verkalydsdagurinn",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Verkalýðsdagurinn",
+		Names:  map[string]string{"is": "Verkalýðsdagurinn", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Uppstigningardagur represents Ascension Day on the 39th day after Easter
-	Uppstigningardagur = aa.AscensionDay.Clone(&cal.Holiday{Name: "Uppstigningardagur", Type: cal.ObservancePublic})
+	Uppstigningardagur = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "is/uppstigningardagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Uppstigningardagur",
+		Names:  map[string]string{"is": "Uppstigningardagur", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Annarihvit represents Whit Monday on the day after Pentecost
-	Annarihvit = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Annar í hvítasunnu", Type: cal.ObservancePublic})
+	Annarihvit = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "is/annarihvit",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Annar í hvítasunnu",
+		Names:  map[string]string{"is": "Annar í hvítasunnu", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Thjodhatid represents Independence Day on 17-Jun
 	Thjodhatid = &cal.Holiday{
-		Name:  "Þjóðhátíðardagurinn",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   17,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "is/thjodhatid",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Þjóðhátíðardagurinn",
+		Names:  map[string]string{"is": "Þjóðhátíðardagurinn", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    17,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Verslunarmannahelgi represents Commerce Day on the first Monday in August
 	Verslunarmannahelgi = &cal.Holiday{
+		ID:      "is/verslunarmannahelgi",
+		Source:  "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
 		Name:    "Frídagur verslunarmanna",
+		Names:   map[string]string{"is": "Frídagur verslunarmanna", "en": "Commerce Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.August,
 		Offset:  1,
@@ -61,26 +112,44 @@ var (
 
 	// Adfangadagur represents Christmas Eve on 24-Dec
 	Adfangadagur = &cal.Holiday{
-		Name:  "Aðfangadagur",
-		Type:  cal.ObservanceOther,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "is/adfangadagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Aðfangadagur",
+		Names:  map[string]string{"is": "Aðfangadagur", "en": "Christmas Eve"},
+		Type:   cal.ObservanceOther,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Joladagur represents Christmas Day on 25-Dec
-	Joladagur = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Jóladagur", Type: cal.ObservancePublic})
+	Joladagur = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "is/joladagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Jóladagur",
+		Names:  map[string]string{"is": "Jóladagur", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Annarijolum represents the second day of Christmas on 26-Dec
-	Annarijolum = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Annar í jólum", Type: cal.ObservancePublic})
+	Annarijolum = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "is/annarijolum",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Annar í jólum",
+		Names:  map[string]string{"is": "Annar í jólum", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Gamarsdagur represents New Year's Eve on 31-Dec
 	Gamarsdagur = &cal.Holiday{
-		Name:  "Gamlársdagur",
-		Type:  cal.ObservanceOther,
-		Month: time.December,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "is/gamarsdagur",
+		Source: "Lög nr. 88/1971 um 40 stunda vinnuviku, 6. gr.",
+		Name:   "Gamlársdagur",
+		Names:  map[string]string{"is": "Gamlársdagur", "en": "New Year's Eve"},
+		Type:   cal.ObservanceOther,
+		Month:  time.December,
+		Day:    31,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -101,3 +170,12 @@ var (
 		Gamarsdagur,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "IS",
+		Alpha3:   "ISL",
+		Name:     "Iceland",
+		Holidays: Holidays,
+	})
+}
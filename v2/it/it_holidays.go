@@ -12,49 +12,109 @@ import (
 
 var (
 	// Capodanno represents New Year's Day on 1-Jan
-	Capodanno = aa.NewYear.Clone(&cal.Holiday{Name: "Capodanno", Type: cal.ObservancePublic})
+	Capodanno = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "it/capodanno",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Capodanno",
+		Names:  map[string]string{"it": "Capodanno", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Epifania represents Epipany on 6-Jan
-	Epifania = aa.Epiphany.Clone(&cal.Holiday{Name: "Epifania", Type: cal.ObservancePublic})
+	Epifania = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "it/epifania",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Epifania",
+		Names:  map[string]string{"it": "Epifania", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pasquetta represents Easter Monday on the day after Easter
-	Pasquetta = aa.EasterMonday.Clone(&cal.Holiday{Name: "Pasquetta", Type: cal.ObservancePublic})
+	Pasquetta = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "it/pasquetta",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Pasquetta",
+		Names:  map[string]string{"it": "Pasquetta", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FestaDellaLiberazione represents Liberation Day on 25-Apr
 	FestaDellaLiberazione = &cal.Holiday{
-		Name:  "Festa della Liberazione",
-		Type:  cal.ObservancePublic,
-		Month: time.April,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "it/festa-della-liberazione",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Festa della Liberazione",
+		Names:  map[string]string{"it": "Festa della Liberazione", "en": "Liberation Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.April,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// FestaDelLavoro represents Labour Day on 1-May
-	FestaDelLavoro = aa.WorkersDay.Clone(&cal.Holiday{Name: "Festa del Lavoro", Type: cal.ObservancePublic})
+	FestaDelLavoro = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "it/festa-del-lavoro",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Festa del Lavoro",
+		Names:  map[string]string{"it": "Festa del Lavoro", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FestaDellaRepubblica represents Republic Day on 2-Jun
 	FestaDellaRepubblica = &cal.Holiday{
-		Name:  "Festa della Repubblica",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "it/festa-della-repubblica",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Festa della Repubblica",
+		Names:  map[string]string{"it": "Festa della Repubblica", "en": "Republic Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Assunzione represents Assumption of Mary on 15-Aug
-	Assunzione = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Assunzione", Type: cal.ObservancePublic})
+	Assunzione = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "it/assunzione",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Assunzione",
+		Names:  map[string]string{"it": "Assunzione", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TuttiISanti represents All Saints' Day on 1-Nov
-	TuttiISanti = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Tutti i santi", Type: cal.ObservancePublic})
+	TuttiISanti = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "it/tutti-i-santi",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Tutti i santi",
+		Names:  map[string]string{"it": "Tutti i santi", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Immacolata represents Immaculate Conception on 8-Dec
-	Immacolata = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Immacolata Concezione", Type: cal.ObservancePublic})
+	Immacolata = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:     "it/immacolata",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Immacolata Concezione",
+		Names:  map[string]string{"it": "Immacolata Concezione", "en": "Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Natale represents Christmas Day on 25-Dec
-	Natale = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Natale", Type: cal.ObservancePublic})
+	Natale = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "it/natale",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Natale",
+		Names:  map[string]string{"it": "Natale", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SantoStefano represents Saint Stephen's Day on 26-Dec
-	SantoStefano = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Santo Stefano", Type: cal.ObservancePublic})
+	SantoStefano = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "it/santo-stefano",
+		Source: "Legge 27 maggio 1949, n. 260",
+		Name:   "Santo Stefano",
+		Names:  map[string]string{"it": "Santo Stefano", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -71,3 +131,12 @@ var (
 		SantoStefano,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "IT",
+		Alpha3:   "ITA",
+		Name:     "Italy",
+		Holidays: Holidays,
+	})
+}
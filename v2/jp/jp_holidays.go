@@ -1,10 +1,27 @@
 // (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
 
 // Package jp provides holiday definitions for Japan.
+//
+// The national holidays ("kokumin no shukujitsu") are defined individually.
+// Substitute holidays ("furikae kyujitsu") and citizens' holidays ("kokumin no
+// kyujitsu") are derived from the full set of national holidays in a year as
+// described in the Act on National Holidays:
+//
+//	Substitute holidays: from 12-Apr-1973, a national holiday falling on
+//	Sunday is observed on the following day. From 2007, it is observed on the
+//	nearest following day that is not a national holiday.
+//
+//	Citizens' holidays: from 27-Dec-1985, a day that falls between two
+//	national holidays is also a holiday. Until 2006, Sundays and substitute
+//	holidays were excluded.
+//
+// Substitute holidays are reported as the observed date of the national
+// holiday that caused them.
 package jp
 
 import (
 	"math"
+	"sync"
 	"time"
 
 	"github.com/rickar/cal/v2"
@@ -12,192 +29,266 @@ import (
 )
 
 var (
-	// Standard Japan weekend substitution rules: Sundays move to Monday
-	weekendAlt = []cal.AltDay{
-		{Day: time.Sunday, Offset: 1},
-	}
-
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:           "jp/new-year",
+		Names:        map[string]string{"ja": "元日"},
+		Source:       "国民の祝日に関する法律 第2条",
+		Type:         cal.ObservancePublic,
+		ObservedFunc: calcSubstituteHoliday,
+	})
 
 	// ComingOfAgeDay represents Coming of Age Day on the 2nd Monday in January
+	// (15-Jan before 2000)
 	ComingOfAgeDay = &cal.Holiday{
-		Name:    "Coming of Age Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.January,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "jp/coming-of-age-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Coming of Age Day",
+		Names:        map[string]string{"en": "Coming of Age Day", "ja": "成人の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.January,
+		Weekday:      time.Monday,
+		Offset:       2,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			if year < 2000 {
+				return time.Date(year, time.January, 15, 0, 0, 0, 0, loc)
+			}
+			return cal.CalcWeekdayOffset(h, year, loc)
+		},
 	}
 
 	// NationalFoundationDay represents National Foundation Day on 11-February
 	NationalFoundationDay = &cal.Holiday{
-		Name:     "National Foundation Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.February,
-		Day:      11,
-		Observed: weekendAlt,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "jp/national-foundation-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "National Foundation Day",
+		Names:        map[string]string{"en": "National Foundation Day", "ja": "建国記念の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.February,
+		Day:          11,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
+		StartYear:    1967,
 	}
 
 	// TheEmperorsBirthday represents The Emperor's Birthday on 23-February
+	// (29-April before 1989 and 23-December from 1989 to 2018)
 	TheEmperorsBirthday = &cal.Holiday{
-		Name:     "The Emperor's Birthday",
-		Type:     cal.ObservancePublic,
-		Month:    time.February,
-		Day:      23,
-		Observed: weekendAlt,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:           "jp/the-emperors-birthday",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "The Emperor's Birthday",
+		Names:        map[string]string{"en": "The Emperor's Birthday", "ja": "天皇誕生日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.February,
+		Day:          23,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			switch {
+			case year <= 1988:
+				// Emperor Showa died in 1989.
+				return time.Date(year, time.April, 29, 0, 0, 0, 0, loc)
 			case year <= 2018:
 				// Emperor Akihito abdicated in 2019.
 				holiday := *h
 				holiday.Month = time.December
 				holiday.Day = 23
-				return cal.CalcDayOfMonth(&holiday, year)
+				return cal.CalcDayOfMonth(&holiday, year, loc)
 			case year == 2019:
 				// There is no official Emperor's Birthday holiday in 2019 due to the transition
 				return time.Time{}
 			default:
-				return cal.CalcDayOfMonth(h, year)
+				return cal.CalcDayOfMonth(h, year, loc)
 			}
 		},
 	}
 
 	// VernalEquinoxDay represents Vernal Equinox Day on Around 20-March
 	VernalEquinoxDay = &cal.Holiday{
-		Name:     "Vernal Equinox Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.March,
-		Observed: weekendAlt,
-		Func: func(h *cal.Holiday, year int) time.Time {
-			holiday := h
+		ID:           "jp/vernal-equinox-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Vernal Equinox Day",
+		Names:        map[string]string{"en": "Vernal Equinox Day", "ja": "春分の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.March,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			holiday := *h
 			holiday.Day = calcVernalEquinoxDate(year)
 
-			return cal.CalcDayOfMonth(holiday, year)
+			return cal.CalcDayOfMonth(&holiday, year, loc)
 		},
 	}
 
 	// ShowaDay represents Showa Day on 29-April
 	ShowaDay = &cal.Holiday{
-		Name:     "Showa Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.April,
-		Day:      29,
-		Observed: weekendAlt,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "jp/showa-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Showa Day",
+		Names:        map[string]string{"en": "Showa Day", "ja": "昭和の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.April,
+		Day:          29,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
+		StartYear:    2007,
 	}
 
 	// ConstitutionMemorialDay represents Constitution Memorial Day on 3-May
 	ConstitutionMemorialDay = &cal.Holiday{
-		Name:  "Constitution Memorial Day",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   3,
-		Observed: []cal.AltDay{
-			{Day: time.Sunday, Offset: 3},
-		},
-		Func: cal.CalcDayOfMonth,
+		ID:           "jp/constitution-memorial-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Constitution Memorial Day",
+		Names:        map[string]string{"en": "Constitution Memorial Day", "ja": "憲法記念日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.May,
+		Day:          3,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
 	}
 
-	// GreeneryDay represents Greenery Day on 4-May
+	// GreeneryDay represents Greenery Day on 4-May (29-April from 1989 to 2006)
 	GreeneryDay = &cal.Holiday{
-		Name:  "Greenery Day",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   4,
-		Observed: []cal.AltDay{
-			{Day: time.Sunday, Offset: 2},
+		ID:           "jp/greenery-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Greenery Day",
+		Names:        map[string]string{"en": "Greenery Day", "ja": "みどりの日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.May,
+		Day:          4,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			if year <= 2006 {
+				return time.Date(year, time.April, 29, 0, 0, 0, 0, loc)
+			}
+			return cal.CalcDayOfMonth(h, year, loc)
 		},
-		Func: cal.CalcDayOfMonth,
+		StartYear: 1989,
 	}
 
 	// ChildrensDay represents Children's Day on 5-May
 	ChildrensDay = &cal.Holiday{
-		Name:     "Children's Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.May,
-		Day:      5,
-		Observed: weekendAlt,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "jp/childrens-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Children's Day",
+		Names:        map[string]string{"en": "Children's Day", "ja": "こどもの日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.May,
+		Day:          5,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
 	}
 
-	// MarineDay represents Marine Day on the 3rd Monday in July
+	// MarineDay represents Marine Day on the 3rd Monday in July (20-July before
+	// 2003)
 	MarineDay = &cal.Holiday{
-		Name:    "Marine Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.July,
-		Weekday: time.Monday,
-		Offset:  3,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:           "jp/marine-day",
+		Source:       "国民の祝日に関する法律 第2条; 平成三十二年東京オリンピック競技大会・東京パラリンピック競技大会特別措置法 (2020 and 2021 dates)",
+		Name:         "Marine Day",
+		Names:        map[string]string{"en": "Marine Day", "ja": "海の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.July,
+		Weekday:      time.Monday,
+		Offset:       3,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			if year < 2003 {
+				return time.Date(year, time.July, 20, 0, 0, 0, 0, loc)
+			}
 			if year == 2020 || year == 2021 {
 				// As special arrangement for the 2020 Summer Olympics, the 2020 and 2021 date for Marine Day was moved
 				holiday := *h
 				holiday.Weekday = time.Thursday
 				holiday.Offset = 4
 
-				return cal.CalcWeekdayOffset(&holiday, year)
+				return cal.CalcWeekdayOffset(&holiday, year, loc)
 			}
 
-			return cal.CalcWeekdayOffset(h, year)
+			return cal.CalcWeekdayOffset(h, year, loc)
 		},
+		StartYear: 1996,
 	}
 
 	// MountainDay represents Mountain Day on 11-August
 	MountainDay = &cal.Holiday{
-		Name:     "Mountain Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.August,
-		Day:      11,
-		Observed: weekendAlt,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:           "jp/mountain-day",
+		Source:       "国民の祝日に関する法律 第2条; 平成三十二年東京オリンピック競技大会・東京パラリンピック競技大会特別措置法 (2020 and 2021 dates)",
+		Name:         "Mountain Day",
+		Names:        map[string]string{"en": "Mountain Day", "ja": "山の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.August,
+		Day:          11,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			switch year {
 			case 2020:
 				// Mountain Day is observed on August 10 in 2020
-				return time.Date(year, time.August, 10, 0, 0, 0, 0, time.Local)
+				return time.Date(year, time.August, 10, 0, 0, 0, 0, loc)
 			case 2021:
 				// Mountain Day is observed on August 8 from 2021
-				return time.Date(year, time.August, 8, 0, 0, 0, 0, time.Local)
+				return time.Date(year, time.August, 8, 0, 0, 0, 0, loc)
 			default:
 				// For all other years, Mountain Day is observed on August 11
-				return time.Date(year, time.August, 11, 0, 0, 0, 0, time.Local)
+				return time.Date(year, time.August, 11, 0, 0, 0, 0, loc)
 			}
 		},
 		StartYear: 2016,
 	}
 
-	// RespectForTheAgedDay represents Respect for the Aged Day on the 3rd Monday in September
+	// RespectForTheAgedDay represents Respect for the Aged Day on the 3rd
+	// Monday in September (15-September before 2003)
 	RespectForTheAgedDay = &cal.Holiday{
-		Name:    "Respect for the Aged Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.September,
-		Weekday: time.Monday,
-		Offset:  3,
-		Func:    cal.CalcWeekdayOffset,
+		ID:           "jp/respect-for-the-aged-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Respect for the Aged Day",
+		Names:        map[string]string{"en": "Respect for the Aged Day", "ja": "敬老の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.September,
+		Weekday:      time.Monday,
+		Offset:       3,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			if year < 2003 {
+				return time.Date(year, time.September, 15, 0, 0, 0, 0, loc)
+			}
+			return cal.CalcWeekdayOffset(h, year, loc)
+		},
+		StartYear: 1966,
 	}
 
 	// AutumnalEquinoxDay represents Autumnal Equinox Day on Around 23-September
 	AutumnalEquinoxDay = &cal.Holiday{
-		Name:     "Autumnal Equinox Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.September,
-		Observed: weekendAlt,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:           "jp/autumnal-equinox-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Autumnal Equinox Day",
+		Names:        map[string]string{"en": "Autumnal Equinox Day", "ja": "秋分の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.September,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
 			holiday := *h
 			holiday.Day = calcAutumnalEquinoxDate(year)
 
-			return cal.CalcDayOfMonth(&holiday, year)
+			return cal.CalcDayOfMonth(&holiday, year, loc)
 		},
 	}
 
-	// SportsDay represents Sports Day on the 2nd Monday in October
+	// SportsDay represents Sports Day on the 2nd Monday in October (10-October
+	// before 2000)
 	SportsDay = &cal.Holiday{
-		Name:    "Sports Day",
-		Type:    cal.ObservancePublic,
-		Month:   time.October,
-		Weekday: time.Monday,
-		Offset:  2,
-		Func: func(h *cal.Holiday, year int) time.Time {
+		ID:           "jp/sports-day",
+		Source:       "国民の祝日に関する法律 第2条; 平成三十二年東京オリンピック競技大会・東京パラリンピック競技大会特別措置法 (2020 and 2021 dates)",
+		Name:         "Sports Day",
+		Names:        map[string]string{"en": "Sports Day", "ja": "スポーツの日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.October,
+		Weekday:      time.Monday,
+		Offset:       2,
+		ObservedFunc: calcSubstituteHoliday,
+		Func: func(h *cal.Holiday, year int, loc *time.Location) time.Time {
+			if year < 2000 {
+				return time.Date(year, time.October, 10, 0, 0, 0, 0, loc)
+			}
 			if year == 2020 || year == 2021 {
 				// As special arrangement for the 2020 Summer Olympics, the 2020 and 2021 date for Sports Day was moved
 				holiday := *h
@@ -205,85 +296,109 @@ var (
 				holiday.Weekday = time.Friday
 				holiday.Offset = 4
 
-				return cal.CalcWeekdayOffset(&holiday, year)
+				return cal.CalcWeekdayOffset(&holiday, year, loc)
 			}
 
-			return cal.CalcWeekdayOffset(h, year)
+			return cal.CalcWeekdayOffset(h, year, loc)
 		},
+		StartYear: 1966,
 	}
 
 	// CultureDay represents Culture Day on 3-November
 	CultureDay = &cal.Holiday{
-		Name:     "Culture Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.November,
-		Day:      3,
-		Observed: weekendAlt,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "jp/culture-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Culture Day",
+		Names:        map[string]string{"en": "Culture Day", "ja": "文化の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.November,
+		Day:          3,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
 	}
 
 	// LaborThanksgivingDay represents Labor Thanksgiving Day on 23-November
 	LaborThanksgivingDay = &cal.Holiday{
-		Name:     "Labor Thanksgiving Day",
-		Type:     cal.ObservancePublic,
-		Month:    time.November,
-		Day:      23,
-		Observed: weekendAlt,
-		Func:     cal.CalcDayOfMonth,
+		ID:           "jp/labor-thanksgiving-day",
+		Source:       "国民の祝日に関する法律 第2条",
+		Name:         "Labor Thanksgiving Day",
+		Names:        map[string]string{"en": "Labor Thanksgiving Day", "ja": "勤労感謝の日"},
+		Type:         cal.ObservancePublic,
+		Month:        time.November,
+		Day:          23,
+		ObservedFunc: calcSubstituteHoliday,
+		Func:         cal.CalcDayOfMonth,
 	}
 
-	// NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay represents National holiday between Respect for the Aged Day and Autumnal Equinox Day in September
-	NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay = &cal.Holiday{
-		Name:  "National holiday between Respect for the Aged Day and Autumnal Equinox Day",
-		Type:  cal.ObservancePublic,
-		Month: time.September,
-		Func: func(h *cal.Holiday, year int) time.Time {
-			switch year {
-			// only dates in September 2009 - 2032 are supported
-			case 2009:
-				return time.Date(year, h.Month, 22, 0, 0, 0, 0, cal.DefaultLoc)
-			case 2015:
-				return time.Date(year, h.Month, 22, 0, 0, 0, 0, cal.DefaultLoc)
-			case 2026:
-				return time.Date(year, h.Month, 22, 0, 0, 0, 0, cal.DefaultLoc)
-			case 2032:
-				return time.Date(year, h.Month, 21, 0, 0, 0, 0, cal.DefaultLoc)
-			default:
-				return time.Time{}
-			}
-		},
+	// CrownPrinceAkihitoWedding represents The Marriage Ceremony of Crown
+	// Prince Akihito on 10-April 1959
+	CrownPrinceAkihitoWedding = &cal.Holiday{
+		ID:        "jp/crown-prince-akihito-wedding",
+		Source:    "皇太子明仁親王の結婚の儀の行われる日を休日とする法律 (1959)",
+		Name:      "The Marriage Ceremony of Crown Prince Akihito",
+		Names:     map[string]string{"en": "The Marriage Ceremony of Crown Prince Akihito", "ja": "皇太子明仁親王の結婚の儀"},
+		Type:      cal.ObservancePublic,
+		Month:     time.April,
+		Day:       10,
+		Func:      cal.CalcDayOfMonth,
+		StartYear: 1959,
+		EndYear:   1959,
 	}
 
-	// NationalHolidayBetweenShowaDayAndNewEmperorEnthronementDay represents
-	// National Holiday Between Showa Day And New Emperor Enthronement Day on 30-April 2019
-	NationalHolidayBetweenShowaDayAndNewEmperorEnthronementDay = &cal.Holiday{
-		Name:      "National Holiday Between Showa Day And New Emperor Enthronement Day",
+	// EmperorShowaFuneral represents The Funeral Ceremony of Emperor Showa on
+	// 24-February 1989
+	EmperorShowaFuneral = &cal.Holiday{
+		ID:        "jp/emperor-showa-funeral",
+		Source:    "昭和天皇の大喪の礼の行われる日を休日とする法律 (1989)",
+		Name:      "The Funeral Ceremony of Emperor Showa",
+		Names:     map[string]string{"en": "The Funeral Ceremony of Emperor Showa", "ja": "昭和天皇の大喪の礼"},
 		Type:      cal.ObservancePublic,
-		Month:     time.April,
-		Day:       30,
+		Month:     time.February,
+		Day:       24,
 		Func:      cal.CalcDayOfMonth,
-		StartYear: 2019,
-		EndYear:   2019,
+		StartYear: 1989,
+		EndYear:   1989,
 	}
 
-	// TheNewEmperorEnthronementDay represents The New Emperor Enthronement Day on 1-May 2019
-	TheNewEmperorEnthronementDay = &cal.Holiday{
-		Name:      "New Emperor Enthronement Day",
+	// EmperorAkihitoEnthronementCeremony represents The Enthronement Ceremony
+	// of Emperor Akihito on 12-November 1990
+	EmperorAkihitoEnthronementCeremony = &cal.Holiday{
+		ID:        "jp/emperor-akihito-enthronement-ceremony",
+		Source:    "即位礼正殿の儀の行われる日を休日とする法律 (1990)",
+		Name:      "The Enthronement Ceremony of Emperor Akihito",
+		Names:     map[string]string{"en": "The Enthronement Ceremony of Emperor Akihito", "ja": "即位礼正殿の儀"},
 		Type:      cal.ObservancePublic,
-		Month:     time.May,
-		Day:       1,
+		Month:     time.November,
+		Day:       12,
 		Func:      cal.CalcDayOfMonth,
-		StartYear: 2019,
-		EndYear:   2019,
+		StartYear: 1990,
+		EndYear:   1990,
 	}
 
-	// NationalHolidayBetweenTheNewEmperorEnthronementDayAndConstitutionMemorialDay represents
-	// National holiday between The New Emperor Enthronement Day and Constitution Memorial Day on 2-May 2019
-	NationalHolidayBetweenTheNewEmperorEnthronementDayAndConstitutionMemorialDay = &cal.Holiday{
-		Name:      "National holiday between New Emperor Enthronement Day and Constitution Memorial Day",
+	// CrownPrinceNaruhitoWedding represents The Marriage Ceremony of Crown
+	// Prince Naruhito on 9-June 1993
+	CrownPrinceNaruhitoWedding = &cal.Holiday{
+		ID:        "jp/crown-prince-naruhito-wedding",
+		Source:    "皇太子徳仁親王の結婚の儀の行われる日を休日とする法律 (1993)",
+		Name:      "The Marriage Ceremony of Crown Prince Naruhito",
+		Names:     map[string]string{"en": "The Marriage Ceremony of Crown Prince Naruhito", "ja": "皇太子徳仁親王の結婚の儀"},
+		Type:      cal.ObservancePublic,
+		Month:     time.June,
+		Day:       9,
+		Func:      cal.CalcDayOfMonth,
+		StartYear: 1993,
+		EndYear:   1993,
+	}
+
+	// TheNewEmperorEnthronementDay represents The New Emperor Enthronement Day on 1-May 2019
+	TheNewEmperorEnthronementDay = &cal.Holiday{
+		ID:        "jp/the-new-emperor-enthronement-day",
+		Source:    "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律 (2018)",
+		Name:      "New Emperor Enthronement Day",
+		Names:     map[string]string{"en": "New Emperor Enthronement Day", "ja": "天皇の即位の日"},
 		Type:      cal.ObservancePublic,
 		Month:     time.May,
-		Day:       2,
+		Day:       1,
 		Func:      cal.CalcDayOfMonth,
 		StartYear: 2019,
 		EndYear:   2019,
@@ -291,7 +406,10 @@ var (
 
 	// TheNewEmperorEnthronementCeremony represents The New Emperor Enthronement Day on 22-October 2019
 	TheNewEmperorEnthronementCeremony = &cal.Holiday{
+		ID:        "jp/the-new-emperor-enthronement-ceremony",
+		Source:    "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律 (2018)",
 		Name:      "The New Emperor Enthronement Ceremony",
+		Names:     map[string]string{"en": "The New Emperor Enthronement Ceremony", "ja": "即位礼正殿の儀"},
 		Type:      cal.ObservancePublic,
 		Month:     time.October,
 		Day:       22,
@@ -300,37 +418,233 @@ var (
 		EndYear:   2019,
 	}
 
+	// NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay represents
+	// the citizens' holiday on 4-May from 1986 to 2006
+	NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay = &cal.Holiday{
+		ID:        "jp/national-holiday-between-constitution-memorial-day-and-childrens-day",
+		Source:    "国民の祝日に関する法律 第3条第3項",
+		Name:      "National holiday between Constitution Memorial Day and Children's Day",
+		Names:     map[string]string{"en": "National holiday between Constitution Memorial Day and Children's Day", "ja": "国民の休日"},
+		Type:      cal.ObservancePublic,
+		Month:     time.May,
+		Func:      calcCitizensHoliday,
+		StartYear: 1986,
+		EndYear:   2006,
+	}
+
+	// NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay represents
+	// the citizens' holiday between Respect for the Aged Day and Autumnal
+	// Equinox Day in September
+	NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay = &cal.Holiday{
+		ID:        "jp/national-holiday-between-respect-for-the-aged-day-and-autumnal-equinox-day",
+		Source:    "国民の祝日に関する法律 第3条第3項",
+		Name:      "National holiday between Respect for the Aged Day and Autumnal Equinox Day",
+		Names:     map[string]string{"en": "National holiday between Respect for the Aged Day and Autumnal Equinox Day", "ja": "国民の休日"},
+		Type:      cal.ObservancePublic,
+		Month:     time.September,
+		Func:      calcCitizensHoliday,
+		StartYear: 1986,
+	}
+
+	// NationalHolidayBetweenShowaDayAndNewEmperorEnthronementDay represents
+	// National Holiday Between Showa Day And New Emperor Enthronement Day on 30-April 2019
+	NationalHolidayBetweenShowaDayAndNewEmperorEnthronementDay = &cal.Holiday{
+		ID:        "jp/national-holiday-between-showa-day-and-new-emperor-enthronement-day",
+		Source:    "国民の祝日に関する法律 第3条第3項; 天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律 (2018)",
+		Name:      "National Holiday Between Showa Day And New Emperor Enthronement Day",
+		Names:     map[string]string{"en": "National Holiday Between Showa Day And New Emperor Enthronement Day", "ja": "国民の休日"},
+		Type:      cal.ObservancePublic,
+		Month:     time.April,
+		Func:      calcCitizensHoliday,
+		StartYear: 2019,
+		EndYear:   2019,
+	}
+
+	// NationalHolidayBetweenTheNewEmperorEnthronementDayAndConstitutionMemorialDay represents
+	// National holiday between The New Emperor Enthronement Day and Constitution Memorial Day on 2-May 2019
+	NationalHolidayBetweenTheNewEmperorEnthronementDayAndConstitutionMemorialDay = &cal.Holiday{
+		ID:        "jp/national-holiday-between-the-new-emperor-enthronement-day-and-constitution-memorial-day",
+		Source:    "国民の祝日に関する法律 第3条第3項; 天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律 (2018)",
+		Name:      "National holiday between New Emperor Enthronement Day and Constitution Memorial Day",
+		Names:     map[string]string{"en": "National holiday between New Emperor Enthronement Day and Constitution Memorial Day", "ja": "国民の休日"},
+		Type:      cal.ObservancePublic,
+		Month:     time.May,
+		Func:      calcCitizensHoliday,
+		StartYear: 2019,
+		EndYear:   2019,
+	}
+
 	exceptionalNationalHolidays = []*cal.Holiday{
+		CrownPrinceAkihitoWedding,
+		EmperorShowaFuneral,
+		EmperorAkihitoEnthronementCeremony,
+		CrownPrinceNaruhitoWedding,
+		TheNewEmperorEnthronementDay,
+		TheNewEmperorEnthronementCeremony,
+	}
+
+	citizensHolidays = []*cal.Holiday{
+		NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay,
 		NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay,
 		NationalHolidayBetweenShowaDayAndNewEmperorEnthronementDay,
-		TheNewEmperorEnthronementDay,
 		NationalHolidayBetweenTheNewEmperorEnthronementDayAndConstitutionMemorialDay,
-		TheNewEmperorEnthronementCeremony,
 	}
 
-	Holidays = append(
-		[]*cal.Holiday{
-			NewYear,
-			ComingOfAgeDay,
-			NationalFoundationDay,
-			TheEmperorsBirthday,
-			VernalEquinoxDay,
-			ShowaDay,
-			ConstitutionMemorialDay,
-			GreeneryDay,
-			ChildrensDay,
-			MarineDay,
-			MountainDay,
-			RespectForTheAgedDay,
-			AutumnalEquinoxDay,
-			SportsDay,
-			CultureDay,
-			LaborThanksgivingDay,
-		},
-		exceptionalNationalHolidays...,
-	)
+	regularHolidays = []*cal.Holiday{
+		NewYear,
+		ComingOfAgeDay,
+		NationalFoundationDay,
+		TheEmperorsBirthday,
+		VernalEquinoxDay,
+		ShowaDay,
+		ConstitutionMemorialDay,
+		GreeneryDay,
+		ChildrensDay,
+		MarineDay,
+		MountainDay,
+		RespectForTheAgedDay,
+		AutumnalEquinoxDay,
+		SportsDay,
+		CultureDay,
+		LaborThanksgivingDay,
+	}
+
+	// Holidays provides a list of the standard national holidays
+	Holidays = concat(regularHolidays, exceptionalNationalHolidays, citizensHolidays)
+
+	// nationalHolidays is the set of holidays used to derive the substitute
+	// and citizens' holidays; set in init to avoid an initialization cycle
+	nationalHolidays []*cal.Holiday
+
+	yearCache      = make(map[int]*yearHolidays)
+	yearCacheMutex sync.RWMutex
 )
 
+// maxCachedYears is the number of years of derived holidays kept in yearCache.
+const maxCachedYears = 64
+
+func init() {
+	nationalHolidays = concat(regularHolidays, exceptionalNationalHolidays)
+
+	cal.Register(&cal.Country{
+		Code:     "JP",
+		Alpha3:   "JPN",
+		Name:     "Japan",
+		Holidays: Holidays,
+	})
+}
+
+// concat returns a new list with the holidays of each of the lists in order.
+func concat(lists ...[]*cal.Holiday) []*cal.Holiday {
+	var r []*cal.Holiday
+	for _, l := range lists {
+		r = append(r, l...)
+	}
+	return r
+}
+
+// yearHolidays holds the derived holidays for a single year. Days are
+// identified by their day of the year.
+type yearHolidays struct {
+	national   map[int]bool // national holidays
+	substitute map[int]int  // national holiday -> substitute holiday
+	citizens   []int        // citizens' holidays in ascending order
+}
+
+// getYearHolidays reports the derived holidays for the given year. Results
+// are cached for up to maxCachedYears years; the cache is emptied when full.
+func getYearHolidays(year int) *yearHolidays {
+	yearCacheMutex.RLock()
+	y, ok := yearCache[year]
+	yearCacheMutex.RUnlock()
+	if ok {
+		return y
+	}
+
+	y = &yearHolidays{
+		national:   make(map[int]bool),
+		substitute: make(map[int]int),
+	}
+	for _, h := range nationalHolidays {
+		// calculate without substitution to avoid recursion
+		hol := *h
+		hol.ObservedFunc = nil
+		act, _ := hol.CalcIn(year, time.UTC)
+		if !act.IsZero() {
+			y.national[act.YearDay()] = true
+		}
+	}
+
+	subStart := time.Date(1973, time.April, 12, 0, 0, 0, 0, time.UTC)
+	for day := range y.national {
+		date := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+		if date.Weekday() != time.Sunday || date.Before(subStart) {
+			continue
+		}
+		// before 2007, the substitute could only be the following day, which
+		// was never a national holiday in those years
+		sub := day + 1
+		for year >= 2007 && y.national[sub] {
+			sub++
+		}
+		y.substitute[day] = sub
+	}
+
+	citStart := time.Date(1985, time.December, 27, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	for day := 2; day < end; day++ {
+		date := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+		if date.Before(citStart) || y.national[day] || !y.national[day-1] || !y.national[day+1] {
+			continue
+		}
+		if year <= 2006 {
+			if date.Weekday() == time.Sunday || y.isSubstitute(day) {
+				continue
+			}
+		}
+		y.citizens = append(y.citizens, day)
+	}
+
+	yearCacheMutex.Lock()
+	if len(yearCache) >= maxCachedYears {
+		yearCache = make(map[int]*yearHolidays)
+	}
+	yearCache[year] = y
+	yearCacheMutex.Unlock()
+	return y
+}
+
+func (y *yearHolidays) isSubstitute(day int) bool {
+	for _, sub := range y.substitute {
+		if sub == day {
+			return true
+		}
+	}
+	return false
+}
+
+// calcSubstituteHoliday reports the substitute holiday for a national holiday
+// that falls on Sunday.
+func calcSubstituteHoliday(h *cal.Holiday, actual time.Time) time.Time {
+	y := getYearHolidays(actual.Year())
+	if sub, ok := y.substitute[actual.YearDay()]; ok {
+		return actual.AddDate(0, 0, sub-actual.YearDay())
+	}
+	return actual
+}
+
+// calcCitizensHoliday reports the first citizens' holiday in the holiday's
+// month.
+func calcCitizensHoliday(h *cal.Holiday, year int, loc *time.Location) time.Time {
+	for _, day := range getYearHolidays(year).citizens {
+		date := time.Date(year, time.January, day, 0, 0, 0, 0, loc)
+		if date.Month() == h.Month {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
 func calcVernalEquinoxDate(year int) int {
 	val := calcEquinoxBase(year)
 
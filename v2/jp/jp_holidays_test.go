@@ -3,6 +3,9 @@
 package jp
 
 import (
+	"encoding/csv"
+	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -148,7 +151,7 @@ func TestHolidays(t *testing.T) {
 
 		{AutumnalEquinoxDay, 1851, d(1851, 9, 24), d(1851, 9, 24)},
 		{AutumnalEquinoxDay, 1899, d(1899, 9, 23), d(1899, 9, 23)},
-		{AutumnalEquinoxDay, 1900, d(1900, 9, 23), d(1900, 9, 24)},
+		{AutumnalEquinoxDay, 1900, d(1900, 9, 23), d(1900, 9, 23)},
 		{AutumnalEquinoxDay, 1979, d(1979, 9, 24), d(1979, 9, 24)},
 		{AutumnalEquinoxDay, 1980, d(1980, 9, 23), d(1980, 9, 23)},
 		{AutumnalEquinoxDay, 2015, d(2015, 9, 23), d(2015, 9, 23)},
@@ -224,3 +227,122 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestSubstituteAndCitizensHolidays(t *testing.T) {
+	tests := []struct {
+		h       *cal.Holiday
+		y       int
+		wantAct time.Time
+		wantObs time.Time
+	}{
+		{ShowaDay, 1973, time.Time{}, time.Time{}},
+		{TheEmperorsBirthday, 1973, d(1973, 4, 29), d(1973, 4, 30)},
+		{NationalFoundationDay, 1973, d(1973, 2, 11), d(1973, 2, 11)},
+		{ConstitutionMemorialDay, 1987, d(1987, 5, 3), d(1987, 5, 4)},
+		{ConstitutionMemorialDay, 2015, d(2015, 5, 3), d(2015, 5, 6)},
+		{GreeneryDay, 2006, d(2006, 4, 29), d(2006, 4, 29)},
+		{GreeneryDay, 2008, d(2008, 5, 4), d(2008, 5, 6)},
+
+		{NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay, 1986, time.Time{}, time.Time{}},
+		{NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay, 1987, time.Time{}, time.Time{}},
+		{NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay, 1988, d(1988, 5, 4), d(1988, 5, 4)},
+		{NationalHolidayBetweenConstitutionMemorialDayAndChildrensDay, 2007, time.Time{}, time.Time{}},
+		{NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay, 2037, d(2037, 9, 22), d(2037, 9, 22)},
+		{NationalHolidayBetweenRespectForTheAgedDayAndAutumnalEquinoxDay, 2043, d(2043, 9, 22), d(2043, 9, 22)},
+	}
+
+	for _, test := range tests {
+		gotAct, gotObs := test.h.Calc(test.y)
+		if !gotAct.Equal(test.wantAct) {
+			t.Errorf("%s %d: got actual: %s, want: %s", test.h.Name, test.y, gotAct.String(), test.wantAct.String())
+		}
+		if !gotObs.Equal(test.wantObs) {
+			t.Errorf("%s %d: got observed: %s, want: %s", test.h.Name, test.y, gotObs.String(), test.wantObs.String())
+		}
+	}
+}
+
+func TestYearCache(t *testing.T) {
+	want := getYearHolidays(2015).substitute
+	for year := 1900; year <= 2100; year++ {
+		getYearHolidays(year)
+		if len(yearCache) > maxCachedYears {
+			t.Fatalf("%d: got %d cached years; want at most %d", year, len(yearCache), maxCachedYears)
+		}
+	}
+	if got := getYearHolidays(2015).substitute; !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	for _, h := range nationalHolidays {
+		for _, c := range citizensHolidays {
+			if h == c {
+				t.Errorf("citizens' holiday %s is a national holiday", h.ID)
+			}
+		}
+	}
+	if len(nationalHolidays)+len(citizensHolidays) != len(Holidays) {
+		t.Errorf("got %d national holidays; want: %d", len(nationalHolidays), len(Holidays)-len(citizensHolidays))
+	}
+}
+
+// TestCabinetOfficeData compares all holidays to the list published by the
+// Cabinet Office (https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv).
+func TestCabinetOfficeData(t *testing.T) {
+	f, err := os.Open("testdata/syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make(map[time.Time]string)
+	first, last := 0, 0
+	for _, r := range records[1:] {
+		date, err := time.ParseInLocation("2006/1/2", r[0], time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[date] = r[1]
+		if first == 0 {
+			first = date.Year()
+		}
+		last = date.Year()
+	}
+
+	c := cal.NewBusinessCalendar()
+	c.AddHoliday(Holidays...)
+
+	for date := time.Date(first, 1, 1, 0, 0, 0, 0, time.UTC); date.Year() <= last; date = date.AddDate(0, 0, 1) {
+		act, obs, h := c.IsHoliday(date)
+		name, ok := want[date]
+		if ok && !act && !obs {
+			t.Errorf("%s: missing holiday %s", date.Format("2006-01-02"), name)
+		} else if !ok && (act || obs) {
+			t.Errorf("%s: unexpected holiday %s", date.Format("2006-01-02"), h.Name)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		h    *cal.Holiday
+		tag  string
+		want string
+	}{
+		{NewYear, "ja", "元日"},
+		{NewYear, "ja-JP", "元日"},
+		{NewYear, "en", "New Year's Day"},
+		{ComingOfAgeDay, "ja", "成人の日"},
+	}
+
+	for _, test := range tests {
+		if got := test.h.NameIn(test.tag); got != test.want {
+			t.Errorf("%s %s: got: %q; want: %q", test.h.Name, test.tag, got, test.want)
+		}
+	}
+}
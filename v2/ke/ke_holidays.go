@@ -19,27 +19,48 @@ var (
 
 	// NewYear represents New Year's Day on 1-Jan
 	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "ke/new-year",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day", "sw": "Mwaka Mpya"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "ke/good-friday",
+		Source: "Public Holidays Act (Cap. 110)",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday", "sw": "Ijumaa Kuu"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "ke/easter-monday",
+		Source: "Public Holidays Act (Cap. 110)",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday", "sw": "Jumatatu ya Pasaka"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labour Day on 1-May
 	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:       "ke/labour-day",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "Labour Day",
+		Names:    map[string]string{"en": "Labour Day", "sw": "Sikukuu ya Wafanyakazi"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
 
 	// MadarakaDay represents Madaraka/Self-Governance Day on 1-Jun
 	MadarakaDay = &cal.Holiday{
+		ID:       "ke/madaraka-day",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "Madaraka Day",
+		Names:    map[string]string{"en": "Madaraka Day", "sw": "Siku ya Madaraka"},
 		Type:     cal.ObservancePublic,
 		Month:    time.June,
 		Day:      1,
@@ -49,7 +70,10 @@ var (
 
 	// UtamaduniDay represents Utamaduni Day on 10-Oct
 	UtamaduniDay = &cal.Holiday{
+		ID:        "ke/utamaduni-day",
+		Source:    "Public Holidays Act (Cap. 110)",
 		Name:      "Utamaduni Day",
+		Names:     map[string]string{"en": "Utamaduni Day", "sw": "Siku ya Utamaduni"},
 		Type:      cal.ObservancePublic,
 		Month:     time.October,
 		Day:       10,
@@ -61,7 +85,10 @@ var (
 
 	// MazingiraDay represents Environment Conservation Day on 10-Oct
 	MazingiraDay = &cal.Holiday{
+		ID:        "ke/mazingira-day",
+		Source:    "Public Holidays Act (Cap. 110)",
 		Name:      "Mazingira Day",
+		Names:     map[string]string{"en": "Mazingira Day", "sw": "Siku ya Mazingira"},
 		Type:      cal.ObservancePublic,
 		Month:     time.October,
 		Day:       10,
@@ -72,7 +99,10 @@ var (
 
 	// MashujaaDay represents Mashujaa/Heroes' Day on 20-Oct
 	MashujaaDay = &cal.Holiday{
+		ID:        "ke/mashujaa-day",
+		Source:    "Public Holidays Act (Cap. 110)",
 		Name:      "Mashujaa Day",
+		Names:     map[string]string{"en": "Mashujaa Day", "sw": "Siku ya Mashujaa"},
 		Type:      cal.ObservancePublic,
 		Month:     time.October,
 		Day:       20,
@@ -83,7 +113,10 @@ var (
 
 	// JamhuriDay represents Jamhuri/Independence Day on 12-Dec
 	JamhuriDay = &cal.Holiday{
+		ID:       "ke/jamhuri-day",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "Jamhuri Day",
+		Names:    map[string]string{"en": "Jamhuri Day", "sw": "Siku ya Jamhuri"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      12,
@@ -93,14 +126,20 @@ var (
 
 	// ChristmasDay represents Christmas Day on 25-Dec
 	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "ke/christmas-day",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day", "sw": "Siku ya Krismasi"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
 
 	// BoxingDay represents Boxing Day on 26-Dec
 	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:       "ke/boxing-day",
+		Source:   "Public Holidays Act (Cap. 110)",
 		Name:     "Boxing Day",
+		Names:    map[string]string{"en": "Boxing Day", "sw": "Siku ya Kupeana Zawadi"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
@@ -119,3 +158,12 @@ var (
 		BoxingDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "KE",
+		Alpha3:   "KEN",
+		Name:     "Kenya",
+		Holidays: Holidays,
+	})
+}
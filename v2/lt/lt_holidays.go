@@ -14,77 +14,137 @@ import (
 
 var (
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Naujieji metai", Type: cal.ObservancePublic})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "lt/new-year",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Naujieji metai",
+		Names:  map[string]string{"lt": "Naujieji metai", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// StateRestorationDay represents Day of Restoration of the State of Lithuania on 16-Feb
 	StateRestorationDay = &cal.Holiday{
-		Name:  "Lietuvos valstybės atkūrimo diena",
-		Type:  cal.ObservancePublic,
-		Month: time.February,
-		Day:   16,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/state-restoration-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Lietuvos valstybės atkūrimo diena",
+		Names:  map[string]string{"lt": "Lietuvos valstybės atkūrimo diena", "en": "Day of Restoration of the State of Lithuania"},
+		Type:   cal.ObservancePublic,
+		Month:  time.February,
+		Day:    16,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// IndependenceDay represents Independence Restoration Day on 11-Mar
 	IndependenceDay = &cal.Holiday{
-		Name:  "Lietuvos nepriklausomybės atkūrimo diena",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   11,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/independence-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Lietuvos nepriklausomybės atkūrimo diena",
+		Names:  map[string]string{"lt": "Lietuvos nepriklausomybės atkūrimo diena", "en": "Day of Restoration of Independence of Lithuania"},
+		Type:   cal.ObservancePublic,
+		Month:  time.March,
+		Day:    11,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// EasterMonday represents Easter Monday on the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Antroji šv. Velykų diena", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "lt/easter-monday",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Antroji šv. Velykų diena",
+		Names:  map[string]string{"lt": "Antroji šv. Velykų diena", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labor Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Tarptautinė darbo diena", Type: cal.ObservancePublic})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "lt/labour-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Tarptautinė darbo diena",
+		Names:  map[string]string{"lt": "Tarptautinė darbo diena", "en": "International Workers' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SaintJohnsEve represents Saint John's Eve on 24-Jun
 	SaintJohnsEve = &cal.Holiday{
-		Name:  "Rasos ir Joninių diena",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/saint-johns-eve",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Rasos ir Joninių diena",
+		Names:  map[string]string{"lt": "Rasos ir Joninių diena", "en": "St. John's Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// StatehoodDay represents Statehood Day on 06-Jul
 	StatehoodDay = &cal.Holiday{
-		Name:  "Valstybės (Lietuvos Karaliaus Mindaugo karūnavimo ir Tautiškos giesmės) diena",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   06,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/statehood-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Valstybės (Lietuvos Karaliaus Mindaugo karūnavimo ir Tautiškos giesmės) diena",
+		Names:  map[string]string{"lt": "Valstybės (Lietuvos Karaliaus Mindaugo karūnavimo ir Tautiškos giesmės) diena", "en": "Statehood Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    06,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// AssumptionDay represents Assumption of Mary on 15-Aug
-	AssumptionDay = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Žolinė (Švč. Mergelės Marijos ėmimo į dangų diena)", Type: cal.ObservancePublic})
+	AssumptionDay = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "lt/assumption-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Žolinė (Švč. Mergelės Marijos ėmimo į dangų diena)",
+		Names:  map[string]string{"lt": "Žolinė (Švč. Mergelės Marijos ėmimo į dangų diena)", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AllSaintsDay represents All Saints' Day on 1-Nov
-	AllSaintsDay = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Visų šventųjų diena", Type: cal.ObservancePublic})
+	AllSaintsDay = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "lt/all-saints-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Visų šventųjų diena",
+		Names:  map[string]string{"lt": "Visų šventųjų diena", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AllSouls represents All Souls' Day on 2-Nov
 	AllSoulsDay = &cal.Holiday{
-		Name:  "Mirusiųjų atminimo (Vėlinių) diena",
-		Month: time.November,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/all-souls-day",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Mirusiųjų atminimo (Vėlinių) diena",
+		Names:  map[string]string{"lt": "Mirusiųjų atminimo (Vėlinių) diena", "en": "All Souls' Day"},
+		Month:  time.November,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasEve represents Christmas Eve on 24-Dec
 	ChristmasEve = &cal.Holiday{
-		Name:  "Šv. Kūčios",
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lt/christmas-eve",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Šv. Kūčios",
+		Names:  map[string]string{"lt": "Šv. Kūčios", "en": "Christmas Eve"},
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasDayOne represents Christmas Day on 25-Dec
-	ChristmasDayOne = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Šv. Kalėdos", Type: cal.ObservancePublic})
+	ChristmasDayOne = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "lt/christmas-day-one",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Šv. Kalėdos",
+		Names:  map[string]string{"lt": "Šv. Kalėdos", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristmasDayTwo represents the second day of Christmas on 26-Dec
-	ChristmasDayTwo = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Šv. Kalėdos (antra diena)", Type: cal.ObservancePublic})
+	ChristmasDayTwo = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "lt/christmas-day-two",
+		Source: "Darbo kodeksas, 123 straipsnis",
+		Name:   "Šv. Kalėdos (antra diena)",
+		Names:  map[string]string{"lt": "Šv. Kalėdos (antra diena)", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -103,3 +163,12 @@ var (
 		ChristmasDayTwo,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "LT",
+		Alpha3:   "LTU",
+		Name:     "Lithuania",
+		Holidays: Holidays,
+	})
+}
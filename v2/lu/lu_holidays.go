@@ -12,40 +12,97 @@ import (
 
 var (
 	// NeitJoer represents New Year's Day on 1-Jan
-	NeitJoer = aa.NewYear.Clone(&cal.Holiday{Name: "Neit Joer", Type: cal.ObservancePublic})
+	NeitJoer = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "lu/neit-joer",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Neit Joer",
+		Names:  map[string]string{"lb": "Neit Joer", "fr": "Jour de l'an", "de": "Neujahr", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Ouschterméindeg represents Easter Monday on the day after Easter
-	Ouschtermeindeg = aa.EasterMonday.Clone(&cal.Holiday{Name: "Ouschterméindeg", Type: cal.ObservancePublic})
+	Ouschtermeindeg = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "lu/ouschtermeindeg",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Ouschterméindeg",
+		Names:  map[string]string{"lb": "Ouschterméindeg", "fr": "Lundi de Pâques", "de": "Ostermontag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DagVunAarbecht represents Labor Day on the first Monday in May
-	DagVunAarbecht = aa.WorkersDay.Clone(&cal.Holiday{Name: "Dag vun der Aarbecht", Type: cal.ObservancePublic})
+	DagVunAarbecht = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "lu/dag-vun-aarbecht",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Dag vun der Aarbecht",
+		Names:  map[string]string{"lb": "Dag vun der Aarbecht", "fr": "Fête du Travail", "de": "Tag der Arbeit", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristiHimmelfaart represents Ascension Day on the 39th day after Easter
-	ChristiHimmelfaart = aa.AscensionDay.Clone(&cal.Holiday{Name: "Christi Himmelfaart", Type: cal.ObservancePublic})
+	ChristiHimmelfaart = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "lu/christi-himmelfaart",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Christi Himmelfaart",
+		Names:  map[string]string{"lb": "Christi Himmelfaart", "fr": "Ascension", "de": "Christi Himmelfahrt", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pengschtméindeg represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	Pengschtméindeg = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Péngschtméindeg", Type: cal.ObservancePublic})
+	Pengschtméindeg = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "lu/pengschtmeindeg",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Péngschtméindeg",
+		Names:  map[string]string{"lb": "Péngschtméindeg", "fr": "Lundi de Pentecôte", "de": "Pfingstmontag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Nationalfeierdag represents Luxembourg National Day on 23-Jul
 	Nationalfeierdag = &cal.Holiday{
-		Name:  "Nationalfeierdag",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   23,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lu/nationalfeierdag",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Nationalfeierdag",
+		Names:  map[string]string{"lb": "Nationalfeierdag", "fr": "Fête nationale", "de": "Nationalfeiertag", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    23,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// MariesHimmelfaart represents Assumption of Mary on 15-Aug
-	MariesHimmelfaart = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Maries Himmelfaart", Type: cal.ObservancePublic})
+	MariesHimmelfaart = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "lu/maries-himmelfaart",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Maries Himmelfaart",
+		Names:  map[string]string{"lb": "Maries Himmelfaart", "fr": "Assomption", "de": "Mariä Himmelfahrt", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Allerhellgen represents All Saints' Day on 1-Nov
-	Allerhellgen = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Allerhellgen", Type: cal.ObservancePublic})
+	Allerhellgen = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "lu/allerhellgen",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Allerhellgen",
+		Names:  map[string]string{"lb": "Allerhellgen", "fr": "Toussaint", "de": "Allerheiligen", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Chrëschtdag represents Christmas Day on 25-Dec
-	Chreschtdag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Chrëschtdag", Type: cal.ObservancePublic})
+	Chreschtdag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "lu/chreschtdag",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Chrëschtdag",
+		Names:  map[string]string{"lb": "Chrëschtdag", "fr": "Noël", "de": "Weihnachten", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ZweetenDagChrëschtdag represents second Christmas Day Day on 26-Dec
-	ZweetenDagChrëschtdag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Zweeten Dag vum Chrëschtdag", Type: cal.ObservancePublic})
+	ZweetenDagChrëschtdag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "lu/zweeten-dag-chreschtdag",
+		Source: "Code du travail, art. L. 232-2",
+		Name:   "Zweeten Dag vum Chrëschtdag",
+		Names:  map[string]string{"lb": "Zweeten Dag vum Chrëschtdag", "fr": "Saint-Étienne", "de": "Zweiter Weihnachtsfeiertag", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -61,3 +118,12 @@ var (
 		ZweetenDagChrëschtdag,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "LU",
+		Alpha3:   "LUX",
+		Name:     "Luxembourg",
+		Holidays: Holidays,
+	})
+}
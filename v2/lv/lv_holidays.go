@@ -22,23 +22,56 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Jaunais Gads", Type: cal.ObservancePublic})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "lv/new-year",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Jaunais Gads",
+		Names:  map[string]string{"lv": "Jaunais Gads", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Lielā Piektdiena", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "lv/good-friday",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Lielā Piektdiena",
+		Names:  map[string]string{"lv": "Lielā Piektdiena", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Easter represents Easter
-	Easter = aa.Easter.Clone(&cal.Holiday{Name: "Pirmās Lieldienas", Type: cal.ObservancePublic})
+	Easter = aa.Easter.Clone(&cal.Holiday{
+		ID:     "lv/easter",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Pirmās Lieldienas",
+		Names:  map[string]string{"lv": "Pirmās Lieldienas", "en": "Easter Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Otrās Lieldienas", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "lv/easter-monday",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Otrās Lieldienas",
+		Names:  map[string]string{"lv": "Otrās Lieldienas", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents International Workers' Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Darba svētki, Latvijas Republikas Satversmes sapulces sasaukšanas diena", Type: cal.ObservancePublic})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "lv/labour-day",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Darba svētki, Latvijas Republikas Satversmes sapulces sasaukšanas diena",
+		Names:  map[string]string{"lv": "Darba svētki, Latvijas Republikas Satversmes sapulces sasaukšanas diena", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// StateRestorationDay represents Day of Restoration of the State of Latvia on 4th May
 	StateRestorationDay = &cal.Holiday{
+		ID:       "lv/state-restoration-day",
+		Source:   "Par svētku, atceres un atzīmējamām dienām",
 		Name:     "Latvijas Republikas Neatkarības deklarācijas pasludināšanas diena",
+		Names:    map[string]string{"lv": "Latvijas Republikas Neatkarības deklarācijas pasludināšanas diena", "en": "Restoration of Independence Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      4,
@@ -48,25 +81,34 @@ var (
 
 	// MidsummerEve represents evening on the summer solstice - 23th of June
 	MidsummerEve = &cal.Holiday{
-		Name:  "Līgo diena",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   23,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lv/midsummer-eve",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Līgo diena",
+		Names:  map[string]string{"lv": "Līgo diena", "en": "Midsummer Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    23,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// MidsummeDay represents day after  the summer solstice - 24th of June
 	MidsummeDay = &cal.Holiday{
-		Name:  "Jāņu diena (vasaras saulgrieži)",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lv/midsumme-day",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Jāņu diena (vasaras saulgrieži)",
+		Names:  map[string]string{"lv": "Jāņu diena (vasaras saulgrieži)", "en": "Midsummer Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// StateProclamationDay represents Proclamation Day of the Republic of Latvia on 18th-Nov
 	StateProclamationDay = &cal.Holiday{
+		ID:       "lv/state-proclamation-day",
+		Source:   "Par svētku, atceres un atzīmējamām dienām",
 		Name:     "Latvijas Republikas proklamēšanas diena",
+		Names:    map[string]string{"lv": "Latvijas Republikas proklamēšanas diena", "en": "Proclamation Day of the Republic of Latvia"},
 		Type:     cal.ObservancePublic,
 		Month:    time.November,
 		Day:      18,
@@ -76,26 +118,44 @@ var (
 
 	// ChristmasEve represents Christmas Eve 24-Dec
 	ChristmasEve = &cal.Holiday{
-		Name:  "Ziemassvētku vakars (ziemas saulgrieži)",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lv/christmas-eve",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Ziemassvētku vakars (ziemas saulgrieži)",
+		Names:  map[string]string{"lv": "Ziemassvētku vakars (ziemas saulgrieži)", "en": "Christmas Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Pirmie Ziemassvētki (ziemas saulgrieži)", Type: cal.ObservancePublic})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "lv/christmas-day",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Pirmie Ziemassvētki (ziemas saulgrieži)",
+		Names:  map[string]string{"lv": "Pirmie Ziemassvētki (ziemas saulgrieži)", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristmasDay2 represents Christmas Second Dat on 26-Dec
-	ChristmasDay2 = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Otrie Ziemassvētki (ziemas saulgrieži)", Type: cal.ObservancePublic})
+	ChristmasDay2 = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "lv/christmas-day-2",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Otrie Ziemassvētki (ziemas saulgrieži)",
+		Names:  map[string]string{"lv": "Otrie Ziemassvētki (ziemas saulgrieži)", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NewYearEve represents New Year's Eve on 31-Dec
 	NewYearEve = &cal.Holiday{
-		Name:  "Vecgada vakars",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "lv/new-year-eve",
+		Source: "Par svētku, atceres un atzīmējamām dienām",
+		Name:   "Vecgada vakars",
+		Names:  map[string]string{"lv": "Vecgada vakars", "en": "New Year's Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    31,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -115,3 +175,12 @@ var (
 		NewYearEve,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "LV",
+		Alpha3:   "LVA",
+		Name:     "Latvia",
+		Holidays: Holidays,
+	})
+}
@@ -12,94 +12,154 @@ import (
 
 var (
 	// L-ewwelTasSena represents New Year's Day on 1-Jan
-	LEwwelTasSena = aa.NewYear.Clone(&cal.Holiday{Name: "L-ewwel tas-Sena", Type: cal.ObservancePublic})
+	LEwwelTasSena = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "mt/l-ewwel-tas-sena",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "L-ewwel tas-Sena",
+		Names:  map[string]string{"mt": "L-ewwel tas-Sena", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NawfragjuSanPawl represents Feast of St. Paul's Shipwreck on 10-Feb
 	NawfragjuSanPawl = &cal.Holiday{
-		Name:  "Nawfraġju ta' San Pawl",
-		Type:  cal.ObservancePublic,
-		Month: time.February,
-		Day:   10,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/nawfragju-san-pawl",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Nawfraġju ta' San Pawl",
+		Names:  map[string]string{"mt": "Nawfraġju ta' San Pawl", "en": "Feast of St. Paul's Shipwreck"},
+		Type:   cal.ObservancePublic,
+		Month:  time.February,
+		Day:    10,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SanGuzepp represents Feast of St. Joseph on 19-Mar
 	SanGuzepp = &cal.Holiday{
-		Name:  "San Ġużepp",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   19,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/san-guzepp",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "San Ġużepp",
+		Names:  map[string]string{"mt": "San Ġużepp", "en": "Feast of St. Joseph"},
+		Type:   cal.ObservancePublic,
+		Month:  time.March,
+		Day:    19,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Il-GimghaKbira represents Good Friday (movable)
-	IlGimghaLKbira = aa.GoodFriday.Clone(&cal.Holiday{Name: "Il-Ġimgħa l-Kbira", Type: cal.ObservancePublic})
+	IlGimghaLKbira = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "mt/il-gimgha-l-kbira",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Il-Ġimgħa l-Kbira",
+		Names:  map[string]string{"mt": "Il-Ġimgħa l-Kbira", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// JumIl-Ħelsien represents Freedom Day on 31-Mar
 	JumIlĦelsien = &cal.Holiday{
-		Name:  "Jum il-Ħelsien",
-		Type:  cal.ObservancePublic,
-		Month: time.March,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/jum-il-helsien",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Jum il-Ħelsien",
+		Names:  map[string]string{"mt": "Jum il-Ħelsien", "en": "Freedom Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.March,
+		Day:    31,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// JumIl-Ħaddiem represents Labour Day on 1-May
-	JumIlĦaddiem = aa.WorkersDay.Clone(&cal.Holiday{Name: "Jum il-Ħaddiem", Type: cal.ObservancePublic})
+	JumIlĦaddiem = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "mt/jum-il-haddiem",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Jum il-Ħaddiem",
+		Names:  map[string]string{"mt": "Jum il-Ħaddiem", "en": "Workers' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SetteGiugno represents Sette Giugno on 7-Jun
 	SetteGiugno = &cal.Holiday{
-		Name:  "Sette Giugno",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   7,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/sette-giugno",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Sette Giugno",
+		Names:  map[string]string{"mt": "Sette Giugno", "en": "Sette Giugno"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    7,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// L-Imnarja represents Feast of St. Peter and St. Paul on 29-Jun
 	LImnarja = &cal.Holiday{
-		Name:  "L-Imnarja",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   29,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/l-imnarja",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "L-Imnarja",
+		Names:  map[string]string{"mt": "L-Imnarja", "en": "Feast of St. Peter and St. Paul"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    29,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SantaMarija represents Feast of the Assumption of Mary on 15-Aug
-	SantaMarija = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Santa Marija", Type: cal.ObservancePublic})
+	SantaMarija = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "mt/santa-marija",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Santa Marija",
+		Names:  map[string]string{"mt": "Santa Marija", "en": "Feast of the Assumption"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// JumIl-Vitorja represents Victory Day on 8-Sep
 	JumIlVitorja = &cal.Holiday{
-		Name:  "Jum il-Vitorja",
-		Type:  cal.ObservancePublic,
-		Month: time.September,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/jum-il-vitorja",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Jum il-Vitorja",
+		Names:  map[string]string{"mt": "Jum il-Vitorja", "en": "Victory Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.September,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// JumL-Indipendenza represents Independence Day on 21-Sep
 	JumLIndipendenza = &cal.Holiday{
-		Name:  "Jum l-Indipendenza",
-		Type:  cal.ObservancePublic,
-		Month: time.September,
-		Day:   21,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/jum-l-indipendenza",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Jum l-Indipendenza",
+		Names:  map[string]string{"mt": "Jum l-Indipendenza", "en": "Independence Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.September,
+		Day:    21,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Il-Kuncizzjoni represents Feast of the Immaculate Conception on 8-Dec
-	IlKuncizzjoni = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Il-Kunċizzjoni", Type: cal.ObservancePublic})
+	IlKuncizzjoni = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:     "mt/il-kuncizzjoni",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Il-Kunċizzjoni",
+		Names:  map[string]string{"mt": "Il-Kunċizzjoni", "en": "Feast of the Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// JumIr-Repubblika represents Republic Day on 13-Dec
 	JumIrRepubblika = &cal.Holiday{
-		Name:  "Jum ir-Repubblika",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   13,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "mt/jum-ir-repubblika",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Jum ir-Repubblika",
+		Names:  map[string]string{"mt": "Jum ir-Repubblika", "en": "Republic Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    13,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Il-Milied represents Christmas Day on 25-Dec
-	IlMilied = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Il-Milied", Type: cal.ObservancePublic})
+	IlMilied = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "mt/il-milied",
+		Source: "National Holidays and Other Public Holidays Act (Cap. 252)",
+		Name:   "Il-Milied",
+		Names:  map[string]string{"mt": "Il-Milied", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -119,3 +179,12 @@ var (
 		IlMilied,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "MT",
+		Alpha3:   "MLT",
+		Name:     "Malta",
+		Holidays: Holidays,
+	})
+}
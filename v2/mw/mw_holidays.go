@@ -20,11 +20,22 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt, Func: cal.CalcDayOfMonth})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "mw/new-year",
+		Source:   "Public Holidays Act",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+		Func:     cal.CalcDayOfMonth,
+	})
 
 	// ChilembweDay represents John Chilembwe Day on the 15th of January
 	ChilembweDay = &cal.Holiday{
+		ID:       "mw/chilembwe-day",
+		Source:   "Public Holidays Act",
 		Name:     "John Chilembwe Day",
+		Names:    map[string]string{"en": "John Chilembwe Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.January,
 		Day:      15,
@@ -34,7 +45,10 @@ var (
 
 	// MartyrsDay represents Martyrs' Day on the 3rd of March
 	MartyrsDay = &cal.Holiday{
+		ID:       "mw/martyrs-day",
+		Source:   "Public Holidays Act",
 		Name:     "Martyrs' Day",
+		Names:    map[string]string{"en": "Martyrs' Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      3,
@@ -43,14 +57,29 @@ var (
 	}
 
 	// GoodFriday represents Good Friday
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "mw/good-friday",
+		Source: "Public Holidays Act",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Easter represents Easter Monday
-	Easter = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservancePublic})
+	Easter = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "mw/easter",
+		Source: "Public Holidays Act",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labour Day on the 1st of May
 	LabourDay = &cal.Holiday{
+		ID:       "mw/labour-day",
+		Source:   "Public Holidays Act",
 		Name:     "Labour Day",
+		Names:    map[string]string{"en": "Labour Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      1,
@@ -60,7 +89,10 @@ var (
 
 	// KamuzuDay represents President Kamuzu Banda's Birthday on the 14th of May
 	KamuzuDay = &cal.Holiday{
+		ID:       "mw/kamuzu-day",
+		Source:   "Public Holidays Act",
 		Name:     "President Kamuzu Banda's Birthday",
+		Names:    map[string]string{"en": "President Kamuzu Banda's Birthday"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      14,
@@ -70,7 +102,10 @@ var (
 
 	// MothersDay represents Mother's Day on the 15th of October
 	MothersDay = &cal.Holiday{
+		ID:       "mw/mothers-day",
+		Source:   "Public Holidays Act",
 		Name:     "Mother's Day",
+		Names:    map[string]string{"en": "Mother's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.October,
 		Day:      15,
@@ -80,7 +115,10 @@ var (
 
 	// IndependenceDay represents Independence Day on the 6th of July
 	IndependenceDay = &cal.Holiday{
+		ID:       "mw/independence-day",
+		Source:   "Public Holidays Act",
 		Name:     "Independence Day",
+		Names:    map[string]string{"en": "Independence Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.July,
 		Day:      6,
@@ -90,7 +128,10 @@ var (
 
 	// BoxingDay represents Christmas Boxing Day on the 26th of December
 	BoxingDay = &cal.Holiday{
+		ID:       "mw/boxing-day",
+		Source:   "Public Holidays Act",
 		Name:     "Christmas Boxing Day",
+		Names:    map[string]string{"en": "Christmas Boxing Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      26,
@@ -99,7 +140,14 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on the 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "mw/christmas-day",
+		Source:   "Public Holidays Act",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -116,3 +164,12 @@ var (
 		ChristmasDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "MW",
+		Alpha3:   "MWI",
+		Name:     "Malawi",
+		Holidays: Holidays,
+	})
+}
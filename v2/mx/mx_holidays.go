@@ -20,11 +20,21 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "mx/new-year",
+		Source:   "Ley Federal del Trabajo, art. 74",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day", "es": "Año Nuevo"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// ConstitutionDay represents Constitution Day on 5-Feb
 	ConstitutionDay = &cal.Holiday{
+		ID:       "mx/constitution-day",
+		Source:   "Ley Federal del Trabajo, art. 74",
 		Name:     "Constitution Day",
+		Names:    map[string]string{"en": "Constitution Day", "es": "Día de la Constitución"},
 		Type:     cal.ObservancePublic,
 		Month:    time.February,
 		Day:      5,
@@ -34,7 +44,10 @@ var (
 
 	// BenitoJuarezDay represents Benito Juárez's Birthday Day on 21-Mar
 	BenitoJuarezDay = &cal.Holiday{
+		ID:       "mx/benito-juarez-day",
+		Source:   "Ley Federal del Trabajo, art. 74",
 		Name:     "Benito Juárez's Birthday",
+		Names:    map[string]string{"en": "Benito Juárez's Birthday", "es": "Natalicio de Benito Juárez"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      21,
@@ -44,7 +57,10 @@ var (
 
 	// LabourDay represents Labour Day on 1-May
 	LabourDay = &cal.Holiday{
+		ID:       "mx/labour-day",
+		Source:   "Ley Federal del Trabajo, art. 74",
 		Name:     "Labour Day",
+		Names:    map[string]string{"en": "Labour Day", "es": "Día del Trabajo"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      1,
@@ -54,7 +70,10 @@ var (
 
 	// IndependenceDay represents Independence Day on 16-Sep
 	IndependenceDay = &cal.Holiday{
+		ID:       "mx/independence-day",
+		Source:   "Ley Federal del Trabajo, art. 74",
 		Name:     "Independence Day",
+		Names:    map[string]string{"en": "Independence Day", "es": "Día de la Independencia"},
 		Type:     cal.ObservancePublic,
 		Month:    time.September,
 		Day:      16,
@@ -64,7 +83,10 @@ var (
 
 	// RevolutionDay represents Revolution Day on the 3rd Monday in November
 	RevolutionDay = &cal.Holiday{
+		ID:      "mx/revolution-day",
+		Source:  "Ley Federal del Trabajo, art. 74",
 		Name:    "Revolution Day",
+		Names:   map[string]string{"en": "Revolution Day", "es": "Día de la Revolución"},
 		Type:    cal.ObservancePublic,
 		Month:   time.November,
 		Offset:  3,
@@ -73,7 +95,14 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "mx/christmas-day",
+		Source:   "Ley Federal del Trabajo, art. 74",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day", "es": "Navidad"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -86,3 +115,12 @@ var (
 		ChristmasDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "MX",
+		Alpha3:   "MEX",
+		Name:     "Mexico",
+		Holidays: Holidays,
+	})
+}
@@ -8,41 +8,43 @@ import (
 
 var (
 	// NouvelAn represents New Year's Day on 1-Jan
-	NouvelAn = fr.NouvelAn.Clone(nil)
+	NouvelAn = fr.NouvelAn.Clone(&cal.Holiday{ID: "nc/nouvel-an"})
 
 	// LundiDePâques represents Easter Monday on the day after Easter
-	LundiDePâques = fr.LundiDePâques.Clone(nil)
+	LundiDePâques = fr.LundiDePâques.Clone(&cal.Holiday{ID: "nc/lundi-de-paques"})
 
 	// FêteDuTravail represents Labour Day on 1-May
-	FêteDuTravail = fr.FêteDuTravail.Clone(nil)
+	FêteDuTravail = fr.FêteDuTravail.Clone(&cal.Holiday{ID: "nc/fete-du-travail"})
 
 	// FêteDeLaVictoire represents Victory in Europe Day on 8-May
-	FêteDeLaVictoire = fr.FêteDeLaVictoire.Clone(nil)
+	FêteDeLaVictoire = fr.FêteDeLaVictoire.Clone(&cal.Holiday{ID: "nc/fete-de-la-victoire"})
 
 	// Ascension represents Ascension Day on the 39th day after Easter
-	Ascension = fr.Ascension.Clone(nil)
+	Ascension = fr.Ascension.Clone(&cal.Holiday{ID: "nc/ascension"})
 
 	// LundiDePentecôte represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	LundiDePentecôte = fr.LundiDePentecôte.Clone(nil)
+	LundiDePentecôte = fr.LundiDePentecôte.Clone(&cal.Holiday{ID: "nc/lundi-de-pentecote"})
 
 	// FêteNationale represents Bastille Day on 14-Jul
-	FêteNationale = fr.FêteNationale.Clone(nil)
+	FêteNationale = fr.FêteNationale.Clone(&cal.Holiday{ID: "nc/fete-nationale"})
 
 	// Assomption represents Assumption of Mary on 15-Aug
-	Assomption = fr.Assomption.Clone(nil)
+	Assomption = fr.Assomption.Clone(&cal.Holiday{ID: "nc/assomption"})
 
 	// Toussaint represents All Saints' Day on 1-Nov
-	Toussaint = fr.Toussaint.Clone(nil)
+	Toussaint = fr.Toussaint.Clone(&cal.Holiday{ID: "nc/toussaint"})
 
 	// Armistice1918 represents Armistice Day on 11-Nov
-	Armistice1918 = fr.Armistice1918.Clone(nil)
+	Armistice1918 = fr.Armistice1918.Clone(&cal.Holiday{ID: "nc/armistice-1918"})
 
 	// Noël represents Christmas Day on 25-Dec
-	Noël = fr.Noël.Clone(nil)
+	Noël = fr.Noël.Clone(&cal.Holiday{ID: "nc/noel"})
 
 	// FêteDeLaCitoyenneté represents the day that New Caledonia became French, the 24-Sept
 	FêteDeLaCitoyenneté = &cal.Holiday{
+		ID:    "nc/fete-de-la-citoyennete",
 		Name:  "Fête de la citoyenneté",
+		Names: map[string]string{"fr": "Fête de la citoyenneté", "en": "Citizenship Day"},
 		Month: time.September,
 		Day:   24,
 		Func:  cal.CalcDayOfMonth,
@@ -64,3 +66,12 @@ var (
 		FêteDeLaCitoyenneté,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "NC",
+		Alpha3:   "NCL",
+		Name:     "New Caledonia",
+		Holidays: Holidays,
+	})
+}
@@ -12,20 +12,46 @@ import (
 
 var (
 	// Nieuwjaar represents New Year's Day on 1-Jan
-	Nieuwjaar = aa.NewYear.Clone(&cal.Holiday{Name: "Nieuwjaarsdag", Type: cal.ObservancePublic})
+	Nieuwjaar = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "nl/nieuwjaar",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Nieuwjaarsdag",
+		Names:  map[string]string{"nl": "Nieuwjaarsdag", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// GoedeVrijdag represents Good Friday on the Friday before Easter
-	GoedeVrijdag = aa.GoodFriday.Clone(&cal.Holiday{Name: "Goede Vrijdag", Type: cal.ObservancePublic})
+	GoedeVrijdag = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "nl/goede-vrijdag",
+		Source: "Collectieve arbeidsovereenkomsten",
+		Name:   "Goede Vrijdag",
+		Names:  map[string]string{"nl": "Goede Vrijdag", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EerstePaasdag represents Easter Sunday
-	EerstePaasdag = aa.Easter.Clone(&cal.Holiday{Name: "Eerste Paasdag", Type: cal.ObservancePublic})
+	EerstePaasdag = aa.Easter.Clone(&cal.Holiday{
+		ID:    "nl/eerste-paasdag",
+		Name:  "Eerste Paasdag",
+		Names: map[string]string{"nl": "Eerste Paasdag", "en": "Easter Sunday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// TweedePaasdag represents Easter Monday on the day after Easter
-	TweedePaasdag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Tweede Paasdag", Type: cal.ObservancePublic})
+	TweedePaasdag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "nl/tweede-paasdag",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Tweede Paasdag",
+		Names:  map[string]string{"nl": "Tweede Paasdag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Koningsdag represents King's Day on 27-Apr
 	Koningsdag = &cal.Holiday{
+		ID:       "nl/koningsdag",
+		Source:   "Algemene termijnenwet, art. 3",
 		Name:     "Koningsdag",
+		Names:    map[string]string{"nl": "Koningsdag", "en": "King's Day"},
 		Month:    time.April,
 		Day:      27,
 		Func:     cal.CalcDayOfMonth,
@@ -34,26 +60,58 @@ var (
 
 	// BevrijdingsDag represents Liberation Day on 5-May
 	BevrijdingsDag = &cal.Holiday{
-		Name:  "Bevrijdingsdag",
-		Month: time.May,
-		Day:   5,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "nl/bevrijdings-dag",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Bevrijdingsdag",
+		Names:  map[string]string{"nl": "Bevrijdingsdag", "en": "Liberation Day"},
+		Month:  time.May,
+		Day:    5,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Hemelvaart represents Ascension Day on the 39th day after Easter
-	Hemelvaart = aa.AscensionDay.Clone(&cal.Holiday{Name: "Hemelvaartsdag", Type: cal.ObservancePublic})
+	Hemelvaart = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "nl/hemelvaart",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Hemelvaartsdag",
+		Names:  map[string]string{"nl": "Hemelvaartsdag", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EerstePinksterDag represents Pentecost on the 49th day after Easter
-	EerstePinksterDag = aa.Pentecost.Clone(&cal.Holiday{Name: "Eerste Pinksterdag", Type: cal.ObservancePublic})
+	EerstePinksterDag = aa.Pentecost.Clone(&cal.Holiday{
+		ID:    "nl/eerste-pinkster-dag",
+		Name:  "Eerste Pinksterdag",
+		Names: map[string]string{"nl": "Eerste Pinksterdag", "en": "Whit Sunday"},
+		Type:  cal.ObservancePublic,
+	})
 
 	// TweedePinksterDag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	TweedePinksterDag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Tweede Pinksterdag", Type: cal.ObservancePublic})
+	TweedePinksterDag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "nl/tweede-pinkster-dag",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Tweede Pinksterdag",
+		Names:  map[string]string{"nl": "Tweede Pinksterdag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EersteKerstdag represents Christmas Day on 25-Dec
-	EersteKerstdag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Eerste Kerstdag", Type: cal.ObservancePublic})
+	EersteKerstdag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "nl/eerste-kerstdag",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Eerste Kerstdag",
+		Names:  map[string]string{"nl": "Eerste Kerstdag", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TweedeKerstdag represents Boxing Day on 26-Dec
-	TweedeKerstdag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Tweede Kerstdag", Type: cal.ObservancePublic})
+	TweedeKerstdag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "nl/tweede-kerstdag",
+		Source: "Algemene termijnenwet, art. 3",
+		Name:   "Tweede Kerstdag",
+		Names:  map[string]string{"nl": "Tweede Kerstdag", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -68,3 +126,12 @@ var (
 		TweedeKerstdag,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "NL",
+		Alpha3:   "NLD",
+		Name:     "Netherlands",
+		Holidays: Holidays,
+	})
+}
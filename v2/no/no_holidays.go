@@ -12,40 +12,97 @@ import (
 
 var (
 	// FoersteNyttaarsdag represents New Year's Day on 1-Jan
-	FoersteNyttaarsdag = aa.NewYear.Clone(&cal.Holiday{Name: "Første nyttårsdag", Type: cal.ObservancePublic})
+	FoersteNyttaarsdag = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "no/foerste-nyttaarsdag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Første nyttårsdag",
+		Names:  map[string]string{"nb": "Første nyttårsdag", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Skjaertorsdag represents Maundy Thursday on the Thursday before Easter
-	Skjaertorsdag = aa.MaundyThursday.Clone(&cal.Holiday{Name: "Skjærtorsdag", Type: cal.ObservancePublic})
+	Skjaertorsdag = aa.MaundyThursday.Clone(&cal.Holiday{
+		ID:     "no/skjaertorsdag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Skjærtorsdag",
+		Names:  map[string]string{"nb": "Skjærtorsdag", "en": "Maundy Thursday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Langfredag represents Good Friday on the Friday before Easter
-	Langfredag = aa.GoodFriday.Clone(&cal.Holiday{Name: "Langfredag", Type: cal.ObservancePublic})
+	Langfredag = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "no/langfredag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Langfredag",
+		Names:  map[string]string{"nb": "Langfredag", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AndrePaaskedag represents Easter Monday on the day after Easter
-	AndrePaaskedag = aa.EasterMonday.Clone(&cal.Holiday{Name: "Andre påskedag", Type: cal.ObservancePublic})
+	AndrePaaskedag = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "no/andre-paaskedag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Andre påskedag",
+		Names:  map[string]string{"nb": "Andre påskedag", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Arbeiderenesdag represents Labour Day on 1-May
-	Arbeiderenesdag = aa.WorkersDay.Clone(&cal.Holiday{Name: "Arbeidernes dag", Type: cal.ObservancePublic})
+	Arbeiderenesdag = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "no/arbeiderenesdag",
+		Source: "Lov om 1. og 17. mai som høgtidsdager (LOV-1947-04-26-1)",
+		Name:   "Arbeidernes dag",
+		Names:  map[string]string{"nb": "Arbeidernes dag", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Grunnlovsdag represents Constitution Day on 17-May
 	Grunnlovsdag = &cal.Holiday{
-		Name:  "Grunnlovsdag",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   17,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "no/grunnlovsdag",
+		Source: "Lov om 1. og 17. mai som høgtidsdager (LOV-1947-04-26-1)",
+		Name:   "Grunnlovsdag",
+		Names:  map[string]string{"nb": "Grunnlovsdag", "en": "Constitution Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    17,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Kristihimmelfartsdag represents Ascension Day on the 39th day after Easter
-	Kristihimmelfartsdag = aa.AscensionDay.Clone(&cal.Holiday{Name: "Kristi Himmelfartsdag", Type: cal.ObservancePublic})
+	Kristihimmelfartsdag = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "no/kristihimmelfartsdag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Kristi Himmelfartsdag",
+		Names:  map[string]string{"nb": "Kristi Himmelfartsdag", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AndrePinsedag represents Pentecost Monday on the day after Pentecost (50 days after Easter)
-	AndrePinsedag = aa.PentecostMonday.Clone(&cal.Holiday{Name: "Andre pinsedag", Type: cal.ObservancePublic})
+	AndrePinsedag = aa.PentecostMonday.Clone(&cal.Holiday{
+		ID:     "no/andre-pinsedag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Andre pinsedag",
+		Names:  map[string]string{"nb": "Andre pinsedag", "en": "Whit Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FoersteJuledag represents Christmas Day on 25-Dec
-	FoersteJuledag = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Første juledag", Type: cal.ObservancePublic})
+	FoersteJuledag = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "no/foerste-juledag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Første juledag",
+		Names:  map[string]string{"nb": "Første juledag", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AndreJuledag represents the second day of Christmas on 26-Dec
-	AndreJuledag = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Andre juledag", Type: cal.ObservancePublic})
+	AndreJuledag = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "no/andre-juledag",
+		Source: "Lov om helligdager og helligdagsfred (LOV-1995-02-24-12)",
+		Name:   "Andre juledag",
+		Names:  map[string]string{"nb": "Andre juledag", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -61,3 +118,12 @@ var (
 		AndreJuledag,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "NO",
+		Alpha3:   "NOR",
+		Name:     "Norway",
+		Holidays: Holidays,
+	})
+}
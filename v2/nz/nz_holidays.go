@@ -20,14 +20,24 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "nz/new-year",
+		Source:   "Holidays Act 2003, s 44",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// DayAfterNewYear represents Day after New Year's Day on 2-Jan
 	DayAfterNewYear = &cal.Holiday{
-		Name:  "Day after New Year's Day",
-		Type:  cal.ObservancePublic,
-		Month: time.January,
-		Day:   2,
+		ID:     "nz/day-after-new-year",
+		Source: "Holidays Act 2003, s 44",
+		Name:   "Day after New Year's Day",
+		Names:  map[string]string{"en": "Day after New Year's Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.January,
+		Day:    2,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
@@ -37,7 +47,10 @@ var (
 
 	// WaitangiDay represents Waitangi Day on 6-Feb
 	WaitangiDay = &cal.Holiday{
+		ID:       "nz/waitangi-day",
+		Source:   "Holidays Act 2003, s 44",
 		Name:     "Waitangi Day",
+		Names:    map[string]string{"en": "Waitangi Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.February,
 		Day:      6,
@@ -46,14 +59,29 @@ var (
 	}
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "nz/good-friday",
+		Source: "Holidays Act 2003, s 44",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Easter Monday", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "nz/easter-monday",
+		Source: "Holidays Act 2003, s 44",
+		Name:   "Easter Monday",
+		Names:  map[string]string{"en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AnzacDay represents ANZAC Day on 25-Apr
 	AnzacDay = &cal.Holiday{
+		ID:       "nz/anzac-day",
+		Source:   "Holidays Act 2003, s 44",
 		Name:     "ANZAC Day",
+		Names:    map[string]string{"en": "ANZAC Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      25,
@@ -63,7 +91,10 @@ var (
 
 	// QueensBirthday represents Queen's Birthday on the first Monday in June
 	QueensBirthday = &cal.Holiday{
+		ID:      "nz/queens-birthday",
+		Source:  "Holidays Act 2003, s 44",
 		Name:    "Queen's Birthday",
+		Names:   map[string]string{"en": "Queen's Birthday"},
 		Type:    cal.ObservancePublic,
 		Month:   time.June,
 		Weekday: time.Monday,
@@ -73,7 +104,10 @@ var (
 
 	// LabourDay represents Labour Day on the fourth Monday in October
 	LabourDay = &cal.Holiday{
+		ID:      "nz/labour-day",
+		Source:  "Holidays Act 2003, s 44",
 		Name:    "Labour Day",
+		Names:   map[string]string{"en": "Labour Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Weekday: time.Monday,
@@ -82,10 +116,22 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "nz/christmas-day",
+		Source:   "Holidays Act 2003, s 44",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// BoxingDay represents Boxing Day on 26-Dec
-	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Boxing Day", Type: cal.ObservanceBank,
+	BoxingDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "nz/boxing-day",
+		Source: "Holidays Act 2003, s 44",
+		Name:   "Boxing Day",
+		Names:  map[string]string{"en": "Boxing Day"},
+		Type:   cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Saturday, Offset: 2},
 			{Day: time.Sunday, Offset: 2},
@@ -105,3 +151,12 @@ var (
 		BoxingDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "NZ",
+		Alpha3:   "NZL",
+		Name:     "New Zealand",
+		Holidays: Holidays,
+	})
+}
@@ -12,41 +12,95 @@ import (
 
 var (
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Nowy Rok", Type: cal.ObservancePublic})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "pl/new-year",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Nowy Rok",
+		Names:  map[string]string{"pl": "Nowy Rok", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ThreeKings represents Epiphany on 6-Jan
-	ThreeKings = aa.Epiphany.Clone(&cal.Holiday{Name: "Święto Trzech Króli", Type: cal.ObservancePublic})
+	ThreeKings = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "pl/three-kings",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Święto Trzech Króli",
+		Names:  map[string]string{"pl": "Święto Trzech Króli", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday on the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "drugi dzień Wielkiej Nocy", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "pl/easter-monday",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "drugi dzień Wielkiej Nocy",
+		Names:  map[string]string{"pl": "drugi dzień Wielkiej Nocy", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labor Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Święto Państwowe", Type: cal.ObservancePublic})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "pl/labour-day",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Święto Państwowe",
+		Names:  map[string]string{"pl": "Święto Państwowe", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ConstitutionDay represents Constitution Day on 3-May
 	ConstitutionDay = &cal.Holiday{
-		Name:  "Święto Narodowe Trzeciego Maja",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   3,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "pl/constitution-day",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Święto Narodowe Trzeciego Maja",
+		Names:  map[string]string{"pl": "Święto Narodowe Trzeciego Maja", "en": "Constitution Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    3,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// CorpusChristi represents Corpus Christi on the 60th day after Easter
-	CorpusChristi = aa.CorpusChristi.Clone(&cal.Holiday{Name: "dzień Bożego Ciała", Type: cal.ObservancePublic})
+	CorpusChristi = aa.CorpusChristi.Clone(&cal.Holiday{
+		ID:     "pl/corpus-christi",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "dzień Bożego Ciała",
+		Names:  map[string]string{"pl": "dzień Bożego Ciała", "en": "Corpus Christi"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AssumptionBlessedVirginMary represents Assumption of Mary on 15-Aug
-	AssumptionBlessedVirginMary = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Wniebowzięcie Najświętszej Maryi Panny", Type: cal.ObservancePublic})
+	AssumptionBlessedVirginMary = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "pl/assumption-blessed-virgin-mary",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Wniebowzięcie Najświętszej Maryi Panny",
+		Names:  map[string]string{"pl": "Wniebowzięcie Najświętszej Maryi Panny", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AllSaints represents All Saints' Day on 1-Nov
-	AllSaints = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Wszystkich Świętych", Type: cal.ObservancePublic})
+	AllSaints = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "pl/all-saints",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Wszystkich Świętych",
+		Names:  map[string]string{"pl": "Wszystkich Świętych", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NationalIndependenceDay represents National Independence Day on 11-Nov
-	NationalIndependenceDay = aa.ArmisticeDay.Clone(&cal.Holiday{Name: "Narodowe Święto Niepodległości", Type: cal.ObservancePublic})
+	NationalIndependenceDay = aa.ArmisticeDay.Clone(&cal.Holiday{
+		ID:     "pl/national-independence-day",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "Narodowe Święto Niepodległości",
+		Names:  map[string]string{"pl": "Narodowe Święto Niepodległości", "en": "Independence Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristmasEve represents Christmas Eve on 24-Dec
 	ChristmasEve = &cal.Holiday{
+		ID:        "pl/christmas-eve",
+		Source:    "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
 		Name:      "Wigilia Bożego Narodzenia",
+		Names:     map[string]string{"pl": "Wigilia Bożego Narodzenia", "en": "Christmas Eve"},
 		Month:     time.December,
 		Day:       24,
 		StartYear: 2025,
@@ -54,10 +108,22 @@ var (
 	}
 
 	// ChristmasDayOne represents Christmas Day on 25-Dec
-	ChristmasDayOne = aa.ChristmasDay.Clone(&cal.Holiday{Name: "pierwszy dzień Bożego Narodzenia", Type: cal.ObservancePublic})
+	ChristmasDayOne = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "pl/christmas-day-one",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "pierwszy dzień Bożego Narodzenia",
+		Names:  map[string]string{"pl": "pierwszy dzień Bożego Narodzenia", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ChristmasDayTwo represents the second day of Christmas on 26-Dec
-	ChristmasDayTwo = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "drugi dzień Bożego Narodzenia", Type: cal.ObservancePublic})
+	ChristmasDayTwo = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "pl/christmas-day-two",
+		Source: "Ustawa z dnia 18 stycznia 1951 r. o dniach wolnych od pracy",
+		Name:   "drugi dzień Bożego Narodzenia",
+		Names:  map[string]string{"pl": "drugi dzień Bożego Narodzenia", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -75,3 +141,12 @@ var (
 		ChristmasDayTwo,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "PL",
+		Alpha3:   "POL",
+		Name:     "Poland",
+		Holidays: Holidays,
+	})
+}
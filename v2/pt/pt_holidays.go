@@ -12,29 +12,59 @@ import (
 
 var (
 	// AnoNovo represents New Year's Day on 1-Jan
-	AnoNovo = aa.NewYear.Clone(&cal.Holiday{Name: "Ano Novo", Type: cal.ObservancePublic})
+	AnoNovo = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "pt/ano-novo",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Ano Novo",
+		Names:  map[string]string{"pt": "Ano Novo", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SextaFeiraSanta represents Good Friday on the Friday before Easter (movable)
-	SextaFeiraSanta = aa.GoodFriday.Clone(&cal.Holiday{Name: "Sexta-feira Santa", Type: cal.ObservancePublic})
+	SextaFeiraSanta = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "pt/sexta-feira-santa",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Sexta-feira Santa",
+		Names:  map[string]string{"pt": "Sexta-feira Santa", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DomingoPascoa represents Easter Sunday (movable)
-	DomingoPascoa = aa.Easter.Clone(&cal.Holiday{Name: "Domingo de Páscoa", Type: cal.ObservancePublic})
+	DomingoPascoa = aa.Easter.Clone(&cal.Holiday{
+		ID:     "pt/domingo-pascoa",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Domingo de Páscoa",
+		Names:  map[string]string{"pt": "Domingo de Páscoa", "en": "Easter Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DiaDaLiberdade represents Freedom Day on 25-Apr
 	DiaDaLiberdade = &cal.Holiday{
-		Name:  "Dia da Liberdade",
-		Type:  cal.ObservancePublic,
-		Month: time.April,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "pt/dia-da-liberdade",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Dia da Liberdade",
+		Names:  map[string]string{"pt": "Dia da Liberdade", "en": "Freedom Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.April,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// DiaDoTrabalhador represents Labour Day on 1-May
-	DiaDoTrabalhador = aa.WorkersDay.Clone(&cal.Holiday{Name: "Dia do Trabalhador", Type: cal.ObservancePublic})
+	DiaDoTrabalhador = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "pt/dia-do-trabalhador",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Dia do Trabalhador",
+		Names:  map[string]string{"pt": "Dia do Trabalhador", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// CorpoDeDeus represents Corpus Christi, 60 days after Easter (movable)
 	CorpoDeDeus = &cal.Holiday{
+		ID:     "pt/corpo-de-deus",
+		Source: "Código do Trabalho, artigo 234.º",
 		Name:   "Corpo de Deus",
+		Names:  map[string]string{"pt": "Corpo de Deus", "en": "Corpus Christi"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Offset: 60,
@@ -42,42 +72,75 @@ var (
 
 	// DiaDePortugal represents Portugal Day on 10-Jun
 	DiaDePortugal = &cal.Holiday{
-		Name:  "Dia de Portugal",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   10,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "pt/dia-de-portugal",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Dia de Portugal",
+		Names:  map[string]string{"pt": "Dia de Portugal", "en": "Portugal Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    10,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// AssuncaoDeNossaSenhora represents Assumption Day on 15-Aug
-	AssuncaoDeNossaSenhora = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Assunção de Nossa Senhora", Type: cal.ObservancePublic})
+	AssuncaoDeNossaSenhora = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "pt/assuncao-de-nossa-senhora",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Assunção de Nossa Senhora",
+		Names:  map[string]string{"pt": "Assunção de Nossa Senhora", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ImplantacaoDaRepublica represents Republic Day on 5-Oct
 	ImplantacaoDaRepublica = &cal.Holiday{
-		Name:  "Implantação da República",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   5,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "pt/implantacao-da-republica",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Implantação da República",
+		Names:  map[string]string{"pt": "Implantação da República", "en": "Republic Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    5,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// TodosOsSantos represents All Saints' Day on 1-Nov
-	TodosOsSantos = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Dia de Todos os Santos", Type: cal.ObservancePublic})
+	TodosOsSantos = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "pt/todos-os-santos",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Dia de Todos os Santos",
+		Names:  map[string]string{"pt": "Dia de Todos os Santos", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// RestauracaoDaIndependencia represents Restoration of Independence Day on 1-Dec
 	RestauracaoDaIndependencia = &cal.Holiday{
-		Name:  "Restauração da Independência",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   1,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "pt/restauracao-da-independencia",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Restauração da Independência",
+		Names:  map[string]string{"pt": "Restauração da Independência", "en": "Restoration of Independence"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    1,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ImaculadaConceicao represents Immaculate Conception on 8-Dec
-	ImaculadaConceicao = aa.ImmaculateConception.Clone(&cal.Holiday{Name: "Imaculada Conceição", Type: cal.ObservancePublic})
+	ImaculadaConceicao = aa.ImmaculateConception.Clone(&cal.Holiday{
+		ID:     "pt/imaculada-conceicao",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Imaculada Conceição",
+		Names:  map[string]string{"pt": "Imaculada Conceição", "en": "Immaculate Conception"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Natal represents Christmas Day on 25-Dec
-	Natal = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Natal", Type: cal.ObservancePublic})
+	Natal = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "pt/natal",
+		Source: "Código do Trabalho, artigo 234.º",
+		Name:   "Natal",
+		Names:  map[string]string{"pt": "Natal", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -96,3 +159,12 @@ var (
 		Natal,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "PT",
+		Alpha3:   "PRT",
+		Name:     "Portugal",
+		Holidays: Holidays,
+	})
+}
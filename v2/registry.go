@@ -0,0 +1,182 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Country holds the holiday definitions and metadata for a country (or other
+// calendar such as a central bank) so that it can be looked up by code at
+// runtime.
+//
+// Country packages register themselves when imported. Import the all package
+// to register every country.
+type Country struct {
+	Code         string         // ISO 3166-1 alpha-2 code, e.g. "DE"
+	Alpha3       string         // ISO 3166-1 alpha-3 code, e.g. "DEU"
+	Name         string         // name of the country
+	Weekend      []time.Weekday // non-working days of the week; Saturday and Sunday if nil
+	Holidays     []*Holiday     // standard national holidays
+	Subdivisions []*Subdivision // regions with their own holiday lists
+}
+
+var (
+	registry      = make(map[string]*Country)
+	registryMutex sync.RWMutex
+)
+
+// Register adds a country to the registry so that it can be found by its
+// alpha-2 or alpha-3 code. A country registered with the same code as an
+// existing one replaces it.
+func Register(c *Country) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry[strings.ToUpper(c.Code)] = c
+}
+
+// LookupCountry reports the registered country with the given ISO 3166-1
+// alpha-2 or alpha-3 code. Codes are matched without regard to case.
+//
+// If no country matches, nil is returned.
+func LookupCountry(code string) *Country {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	code = strings.ToUpper(code)
+	if c, ok := registry[code]; ok {
+		return c
+	}
+	for _, c := range registry {
+		if c.Alpha3 != "" && strings.ToUpper(c.Alpha3) == code {
+			return c
+		}
+	}
+	return nil
+}
+
+// Countries reports all registered countries ordered by code.
+func Countries() []*Country {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	r := make([]*Country, 0, len(registry))
+	for _, c := range registry {
+		r = append(r, c)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Code < r[j].Code })
+	return r
+}
+
+// LookupSubdivision reports the subdivision of a registered country with the
+// given ISO 3166-2 code such as "CH-ZH". Codes are matched without regard to
+// case.
+//
+// If no subdivision matches, nil is returned.
+func LookupSubdivision(code string) *Subdivision {
+	i := strings.IndexByte(code, '-')
+	if i < 0 {
+		return nil
+	}
+	c := LookupCountry(code[:i])
+	if c == nil {
+		return nil
+	}
+	return FindSubdivision(c.Subdivisions, code)
+}
+
+// LookupHoliday reports the holiday of a registered country with the given
+// ID, such as "gb/boxing-day". The country's national holidays are searched
+// before its subdivisions' holidays.
+//
+// If no holiday matches, nil is returned.
+func LookupHoliday(id string) *Holiday {
+	i := strings.IndexByte(id, '/')
+	if i < 0 {
+		return nil
+	}
+	c := LookupCountry(id[:i])
+	if c == nil {
+		return nil
+	}
+	lists := [][]*Holiday{c.Holidays}
+	for _, s := range c.Subdivisions {
+		lists = append(lists, s.Holidays)
+	}
+	for _, l := range lists {
+		for _, h := range l {
+			if h.ID == id {
+				return h
+			}
+		}
+	}
+	return nil
+}
+
+// NewBusinessCalendarFor creates a new BusinessCalendar for a registered
+// country code (e.g. "DE" or "DEU") or subdivision code (e.g. "DE-BY"). The
+// country's weekend is used to set the workdays.
+//
+// If the code is not registered, nil is returned.
+func NewBusinessCalendarFor(code string) *BusinessCalendar {
+	i := strings.IndexByte(code, '-')
+	if i < 0 {
+		if c := LookupCountry(code); c != nil {
+			return c.NewBusinessCalendar()
+		}
+		return nil
+	}
+
+	c := LookupCountry(code[:i])
+	if c == nil {
+		return nil
+	}
+	s := FindSubdivision(c.Subdivisions, code)
+	if s == nil {
+		return nil
+	}
+	b := s.NewBusinessCalendar()
+	c.setWorkdays(b)
+	return b
+}
+
+// IsWeekend reports whether the given day is part of the country's weekend.
+func (c *Country) IsWeekend(day time.Weekday) bool {
+	if c.Weekend == nil {
+		return day == time.Saturday || day == time.Sunday
+	}
+	for _, d := range c.Weekend {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCalendar creates a new Calendar with the country's national holidays.
+func (c *Country) NewCalendar() *Calendar {
+	cal := &Calendar{Name: c.Code, Description: c.Name}
+	cal.AddHoliday(c.Holidays...)
+	return cal
+}
+
+// NewBusinessCalendar creates a new BusinessCalendar with the country's
+// national holidays and workdays on every day that is not part of the
+// weekend.
+func (c *Country) NewBusinessCalendar() *BusinessCalendar {
+	b := NewBusinessCalendar()
+	b.Name = c.Code
+	b.Description = c.Name
+	b.AddHoliday(c.Holidays...)
+	c.setWorkdays(b)
+	return b
+}
+
+func (c *Country) setWorkdays(b *BusinessCalendar) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		b.SetWorkday(d, !c.IsWeekend(d))
+	}
+}
@@ -0,0 +1,133 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	hol := &Holiday{ID: "xx/national", Name: "National", Month: time.March, Day: 2, Func: CalcDayOfMonth}
+	reg := &Holiday{ID: "xx/regional", Name: "Regional", Month: time.March, Day: 3, Func: CalcDayOfMonth}
+	xx := &Country{
+		Code:     "XX",
+		Alpha3:   "XXX",
+		Name:     "Test Country",
+		Weekend:  []time.Weekday{time.Friday, time.Saturday},
+		Holidays: []*Holiday{hol},
+		Subdivisions: []*Subdivision{
+			{Code: "XX-AA", Name: "Region A", Holidays: []*Holiday{hol, reg}},
+		},
+	}
+	xy := &Country{Code: "XY", Name: "Other Country"}
+	Register(xy)
+	Register(xx)
+	defer func() {
+		registryMutex.Lock()
+		delete(registry, "XX")
+		delete(registry, "XY")
+		registryMutex.Unlock()
+	}()
+
+	lookups := []struct {
+		code string
+		want *Country
+	}{
+		{"XX", xx},
+		{"xx", xx},
+		{"XXX", xx},
+		{"xy", xy},
+		{"XYZ", nil},
+		{"", nil},
+	}
+	for _, test := range lookups {
+		if got := LookupCountry(test.code); got != test.want {
+			t.Errorf("%q: got: %v; want: %v", test.code, got, test.want)
+		}
+	}
+
+	found := 0
+	all := Countries()
+	for i, c := range all {
+		if i > 0 && all[i-1].Code > c.Code {
+			t.Errorf("countries not ordered: %s before %s", all[i-1].Code, c.Code)
+		}
+		if c == xx || c == xy {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Errorf("got %d test countries; want 2", found)
+	}
+
+	if s := LookupSubdivision("xx-aa"); s != xx.Subdivisions[0] {
+		t.Errorf("bad subdivision lookup: %v", s)
+	}
+	if s := LookupSubdivision("XX-BB"); s != nil {
+		t.Errorf("expected nil subdivision; got: %v", s)
+	}
+	if s := LookupSubdivision("XX"); s != nil {
+		t.Errorf("expected nil subdivision; got: %v", s)
+	}
+	if s := LookupSubdivision("ZZ-AA"); s != nil {
+		t.Errorf("expected nil subdivision; got: %v", s)
+	}
+
+	holidays := []struct {
+		id   string
+		want *Holiday
+	}{
+		{"xx/national", hol},
+		{"xx/regional", reg},
+		{"xx/other", nil},
+		{"zz/national", nil},
+		{"national", nil},
+	}
+	for _, test := range holidays {
+		if got := LookupHoliday(test.id); got != test.want {
+			t.Errorf("%q: got: %v; want: %v", test.id, got, test.want)
+		}
+	}
+
+	calendars := []struct {
+		code    string
+		date    time.Time
+		wantNil bool
+		want    bool
+	}{
+		{"XX", d(2021, 3, 2), false, false}, // holiday
+		{"XX", d(2021, 3, 3), false, true},  // regional holiday only
+		{"XX", d(2021, 3, 5), false, false}, // Friday weekend
+		{"XX", d(2021, 3, 7), false, true},  // Sunday workday
+		{"XXX", d(2021, 3, 7), false, true}, // alpha-3
+		{"XX-AA", d(2021, 3, 3), false, false},
+		{"xx-aa", d(2021, 3, 5), false, false},
+		{"XY", d(2021, 3, 6), false, false}, // default weekend
+		{"XY", d(2021, 3, 5), false, true},
+		{"XX-BB", d(2021, 3, 5), true, false},
+		{"ZZ-AA", d(2021, 3, 5), true, false},
+		{"ZZ", d(2021, 3, 5), true, false},
+	}
+	for _, test := range calendars {
+		c := NewBusinessCalendarFor(test.code)
+		if test.wantNil {
+			if c != nil {
+				t.Errorf("%q: expected nil calendar", test.code)
+			}
+			continue
+		}
+		if c == nil {
+			t.Errorf("%q: unexpected nil calendar", test.code)
+			continue
+		}
+		if got := c.IsWorkday(test.date); got != test.want {
+			t.Errorf("%q %s: got: %t; want: %t", test.code, test.date, got, test.want)
+		}
+	}
+
+	c := xx.NewCalendar()
+	if c.Name != "XX" || c.Description != "Test Country" || len(c.Holidays) != 1 {
+		t.Errorf("bad calendar: %s, %s, %v", c.Name, c.Description, c.Holidays)
+	}
+}
@@ -13,22 +13,31 @@ import (
 var (
 	// AnulNou represents New Year's Day on 1-Jan
 	AnulNou = aa.NewYear.Clone(&cal.Holiday{
-		Name: "Anul Nou",
-		Type: cal.ObservancePublic,
+		ID:     "ro/anul-nou",
+		Source: "Codul muncii, art. 139",
+		Name:   "Anul Nou",
+		Names:  map[string]string{"ro": "Anul Nou", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
 	})
 
 	// AnulNou2 represents New Year's Second Day on 2-Jan
 	AnulNou2 = &cal.Holiday{
-		Name:  "A doua zi de Anul Nou",
-		Type:  cal.ObservancePublic,
-		Month: time.January,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ro/anul-nou-2",
+		Source: "Codul muncii, art. 139",
+		Name:   "A doua zi de Anul Nou",
+		Names:  map[string]string{"ro": "A doua zi de Anul Nou", "en": "Day after New Year's Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.January,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Boboteaza represents Epiphany on 6-Jan
 	Boboteaza = &cal.Holiday{
+		ID:        "ro/boboteaza",
+		Source:    "Codul muncii, art. 139",
 		Name:      "Boboteaza",
+		Names:     map[string]string{"ro": "Boboteaza", "en": "Epiphany"},
 		Type:      cal.ObservancePublic,
 		Month:     time.January,
 		Day:       6,
@@ -38,7 +47,10 @@ var (
 
 	// SfantulIon represents the celebration of Saint John the Baptist
 	SfantulIon = &cal.Holiday{
+		ID:        "ro/sfantul-ion",
+		Source:    "Codul muncii, art. 139",
 		Name:      "Sfântul Ion",
+		Names:     map[string]string{"ro": "Sfântul Ion", "en": "St. John the Baptist"},
 		Type:      cal.ObservancePublic,
 		Month:     1,
 		Day:       7,
@@ -48,16 +60,22 @@ var (
 
 	// ZiuaUniriiPrincipatelorRomane represents the day when, in 1859,  the 2 Romanian principalities, Moldavia and Wallachia, united.
 	ZiuaUniriiPrincipatelorRomane = &cal.Holiday{
-		Name:  "Ziua Unirii Principatelor Române",
-		Type:  cal.ObservancePublic,
-		Month: time.January,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ro/ziua-unirii-principatelor-romane",
+		Source: "Codul muncii, art. 139",
+		Name:   "Ziua Unirii Principatelor Române",
+		Names:  map[string]string{"ro": "Ziua Unirii Principatelor Române", "en": "Union Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.January,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// VinereaMare represents Good Friday - two days before Easter
 	VinereaMare = &cal.Holiday{
+		ID:        "ro/vinerea-mare",
+		Source:    "Codul muncii, art. 139",
 		Name:      "Vinerea Mare",
+		Names:     map[string]string{"ro": "Vinerea Mare", "en": "Good Friday"},
 		Type:      cal.ObservancePublic,
 		Offset:    -2,
 		Julian:    true,
@@ -67,7 +85,10 @@ var (
 
 	// Pastele represents the day of Easter
 	Pastele = &cal.Holiday{
+		ID:     "ro/pastele",
+		Source: "Codul muncii, art. 139",
 		Name:   "Paștele",
+		Names:  map[string]string{"ro": "Paștele", "en": "Easter Sunday"},
 		Type:   cal.ObservancePublic,
 		Julian: true,
 		Func:   cal.CalcEasterOffset,
@@ -75,7 +96,10 @@ var (
 
 	// ADouaZiDePaste represents Easter Monday on the day after Easter
 	ADouaZiDePaste = &cal.Holiday{
+		ID:     "ro/a-doua-zi-de-paste",
+		Source: "Codul muncii, art. 139",
 		Name:   "A doua zi de Paște",
+		Names:  map[string]string{"ro": "A doua zi de Paște", "en": "Easter Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 1,
 		Julian: true,
@@ -84,13 +108,19 @@ var (
 
 	// ZiuaMuncii represents Labour Day on 1-May
 	ZiuaMuncii = aa.WorkersDay.Clone(&cal.Holiday{
-		Name: "Ziua Muncii",
-		Type: cal.ObservancePublic,
+		ID:     "ro/ziua-muncii",
+		Source: "Codul muncii, art. 139",
+		Name:   "Ziua Muncii",
+		Names:  map[string]string{"ro": "Ziua Muncii", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
 	})
 
 	// ZiuaCopilului represents Children's Day on 1-June
 	ZiuaCopilului = &cal.Holiday{
+		ID:        "ro/ziua-copilului",
+		Source:    "Codul muncii, art. 139",
 		Name:      "Ziua Copilului",
+		Names:     map[string]string{"ro": "Ziua Copilului", "en": "Children's Day"},
 		Type:      cal.ObservancePublic,
 		Month:     time.June,
 		Day:       1,
@@ -100,7 +130,10 @@ var (
 
 	// Rusalii represents Pentecoast Sunday on the 49th day after Easter
 	Rusalii = &cal.Holiday{
+		ID:     "ro/rusalii",
+		Source: "Codul muncii, art. 139",
 		Name:   "Rusalii",
+		Names:  map[string]string{"ro": "Rusalii", "en": "Whit Sunday"},
 		Type:   cal.ObservancePublic,
 		Offset: 49,
 		Julian: true,
@@ -109,7 +142,10 @@ var (
 
 	// LuniDupaRusalii represents Pentecost Monday on the day after Pentecost (50 days after Easter)
 	LuniDupaRusalii = &cal.Holiday{
+		ID:     "ro/luni-dupa-rusalii",
+		Source: "Codul muncii, art. 139",
 		Name:   "Luni după Rusalii",
+		Names:  map[string]string{"ro": "Luni după Rusalii", "en": "Whit Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 50,
 		Julian: true,
@@ -118,38 +154,53 @@ var (
 
 	// AdormireaMaiciiDomnului represents Assumption of Mary on 15-Aug
 	AdormireaMaiciiDomnului = aa.AssumptionOfMary.Clone(&cal.Holiday{
-		Name: "Adormirea Maicii Domnului",
-		Type: cal.ObservancePublic,
+		ID:     "ro/adormirea-maicii-domnului",
+		Source: "Codul muncii, art. 139",
+		Name:   "Adormirea Maicii Domnului",
+		Names:  map[string]string{"ro": "Adormirea Maicii Domnului", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
 	})
 
 	// SfantulAndrei represents Saint Andrew's Day on 30-Nov
 	SfantulAndrei = &cal.Holiday{
-		Name:  "Sfântul Andrei",
-		Type:  cal.ObservancePublic,
-		Month: time.November,
-		Day:   30,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ro/sfantul-andrei",
+		Source: "Codul muncii, art. 139",
+		Name:   "Sfântul Andrei",
+		Names:  map[string]string{"ro": "Sfântul Andrei", "en": "St. Andrew's Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.November,
+		Day:    30,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ZiuaNationala represents Great Union Day on 1-Dec
 	ZiuaNationala = &cal.Holiday{
-		Name:  "Ziua Națională a României",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   1,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "ro/ziua-nationala",
+		Source: "Codul muncii, art. 139",
+		Name:   "Ziua Națională a României",
+		Names:  map[string]string{"ro": "Ziua Națională a României", "en": "National Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    1,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Craciunul represents Christmas Day on 25-Dec
 	Craciunul = aa.ChristmasDay.Clone(&cal.Holiday{
-		Name: "Crăciunul",
-		Type: cal.ObservancePublic,
+		ID:     "ro/craciunul",
+		Source: "Codul muncii, art. 139",
+		Name:   "Crăciunul",
+		Names:  map[string]string{"ro": "Crăciunul", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
 	})
 
 	// Craciunul2 represents the day after Christmas (Boxing Day / St. Stephen's Day) on 26-Dec
 	Craciunul2 = aa.ChristmasDay2.Clone(&cal.Holiday{
-		Name: "A doua zi de Crăciun",
-		Type: cal.ObservancePublic,
+		ID:     "ro/craciunul-2",
+		Source: "Codul muncii, art. 139",
+		Name:   "A doua zi de Crăciun",
+		Names:  map[string]string{"ro": "A doua zi de Crăciun", "en": "Second Day of Christmas"},
+		Type:   cal.ObservancePublic,
 	})
 
 	// Holidays provides a list of the standard national holidays
@@ -173,3 +224,12 @@ var (
 		Craciunul2,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "RO",
+		Alpha3:   "ROU",
+		Name:     "Romania",
+		Holidays: Holidays,
+	})
+}
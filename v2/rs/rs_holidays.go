@@ -12,20 +12,32 @@ import (
 
 var (
 	// NovaGodina represents New Year's Day on 1-Jan
-	NovaGodina = aa.NewYear.Clone(&cal.Holiday{Name: "Nova godina", Type: cal.ObservancePublic})
+	NovaGodina = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "rs/nova-godina",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Nova godina",
+		Names:  map[string]string{"sr": "Nova godina", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DrugiDanNoveGodine represents the second day of New Year's on 2-Jan
 	DrugiDanNoveGodine = &cal.Holiday{
-		Name:  "Drugi dan Nove godine",
-		Type:  cal.ObservancePublic,
-		Month: time.January,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "rs/drugi-dan-nove-godine",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Drugi dan Nove godine",
+		Names:  map[string]string{"sr": "Drugi dan Nove godine", "en": "Second Day of New Year"},
+		Type:   cal.ObservancePublic,
+		Month:  time.January,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Bozic represents Orthodox Christmas Day on 7-Jan (Julian calendar)
 	Bozic = &cal.Holiday{
+		ID:     "rs/bozic",
+		Source: "Закон о државним и другим празницима у Републици Србији",
 		Name:   "Božić",
+		Names:  map[string]string{"sr": "Božić", "en": "Orthodox Christmas"},
 		Type:   cal.ObservancePublic,
 		Month:  time.January,
 		Day:    7,
@@ -35,37 +47,55 @@ var (
 
 	// DanDrzavnosti represents Statehood Day on 15-Feb
 	DanDrzavnosti = &cal.Holiday{
-		Name:  "Dan državnosti",
-		Type:  cal.ObservancePublic,
-		Month: time.February,
-		Day:   15,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "rs/dan-drzavnosti",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Dan državnosti",
+		Names:  map[string]string{"sr": "Dan državnosti", "en": "Statehood Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.February,
+		Day:    15,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// DrugiDanDrzavnosti represents the second day of Statehood Day on 16-Feb
 	DrugiDanDrzavnosti = &cal.Holiday{
-		Name:  "Drugi dan Dana državnosti",
-		Type:  cal.ObservancePublic,
-		Month: time.February,
-		Day:   16,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "rs/drugi-dan-drzavnosti",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Drugi dan Dana državnosti",
+		Names:  map[string]string{"sr": "Drugi dan Dana državnosti", "en": "Second Day of Statehood Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.February,
+		Day:    16,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// PraznikRada represents Labour Day on 1-May
-	PraznikRada = aa.WorkersDay.Clone(&cal.Holiday{Name: "Praznik rada", Type: cal.ObservancePublic})
+	PraznikRada = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "rs/praznik-rada",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Praznik rada",
+		Names:  map[string]string{"sr": "Praznik rada", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DrugiDanPraznikaRada represents the second day of Labour Day on 2-May
 	DrugiDanPraznikaRada = &cal.Holiday{
-		Name:  "Drugi dan Praznika rada",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "rs/drugi-dan-praznika-rada",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Drugi dan Praznika rada",
+		Names:  map[string]string{"sr": "Drugi dan Praznika rada", "en": "Second Day of Labour Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// VelikiPetak represents Orthodox Good Friday (movable, 2 days before Orthodox Easter)
 	VelikiPetak = &cal.Holiday{
+		ID:     "rs/veliki-petak",
+		Source: "Закон о државним и другим празницима у Републици Србији",
 		Name:   "Veliki petak",
+		Names:  map[string]string{"sr": "Veliki petak", "en": "Good Friday"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Julian: true,
@@ -74,7 +104,10 @@ var (
 
 	// Vaskrs represents Orthodox Easter Sunday (movable)
 	Vaskrs = &cal.Holiday{
+		ID:     "rs/vaskrs",
+		Source: "Закон о државним и другим празницима у Републици Србији",
 		Name:   "Vaskrs",
+		Names:  map[string]string{"sr": "Vaskrs", "en": "Easter Sunday"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Julian: true,
@@ -82,7 +115,10 @@ var (
 
 	// VaskrsnjiPonedeljak represents Orthodox Easter Monday (movable, 1 day after Orthodox Easter)
 	VaskrsnjiPonedeljak = &cal.Holiday{
+		ID:     "rs/vaskrsnji-ponedeljak",
+		Source: "Закон о државним и другим празницима у Републици Србији",
 		Name:   "Vaskršnji ponedeljak",
+		Names:  map[string]string{"sr": "Vaskršnji ponedeljak", "en": "Easter Monday"},
 		Type:   cal.ObservancePublic,
 		Func:   cal.CalcEasterOffset,
 		Julian: true,
@@ -91,11 +127,14 @@ var (
 
 	// DanPrimirja represents Armistice Day on 11-Nov
 	DanPrimirja = &cal.Holiday{
-		Name:  "Dan primirja u Prvom svetskom ratu",
-		Type:  cal.ObservancePublic,
-		Month: time.November,
-		Day:   11,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "rs/dan-primirja",
+		Source: "Закон о државним и другим празницима у Републици Србији",
+		Name:   "Dan primirja u Prvom svetskom ratu",
+		Names:  map[string]string{"sr": "Dan primirja u Prvom svetskom ratu", "en": "Armistice Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.November,
+		Day:    11,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -113,3 +152,12 @@ var (
 		DanPrimirja,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "RS",
+		Alpha3:   "SRB",
+		Name:     "Serbia",
+		Holidays: Holidays,
+	})
+}
@@ -21,14 +21,20 @@ var (
 
 	// NewYear represents New Year's Day on 1-Jan
 	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "ru/new-year",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "Новый Год",
+		Names:    map[string]string{"ru": "Новый Год", "en": "New Year's Day"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
 
 	// OrthodoxChristmas represents Orthodox Christmas on 7-Jan
 	OrthodoxChristmas = &cal.Holiday{
+		ID:       "ru/orthodox-christmas",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "Рождество Христово",
+		Names:    map[string]string{"ru": "Рождество Христово", "en": "Orthodox Christmas"},
 		Type:     cal.ObservancePublic,
 		Month:    time.January,
 		Day:      7,
@@ -38,7 +44,10 @@ var (
 
 	// MilitaryDay represents Defender of the Fatherland Day 23-Feb
 	MilitaryDay = &cal.Holiday{
+		ID:       "ru/military-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "День защитника Отечества",
+		Names:    map[string]string{"ru": "День защитника Отечества", "en": "Defender of the Fatherland Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.February,
 		Day:      23,
@@ -48,7 +57,10 @@ var (
 
 	// WomensDay represents International Women's Day 8-Mar
 	WomensDay = &cal.Holiday{
+		ID:       "ru/womens-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "Международный женский день",
+		Names:    map[string]string{"ru": "Международный женский день", "en": "International Women's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      8,
@@ -58,13 +70,19 @@ var (
 
 	// LabourDay represents Labour Day on 1-May
 	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:       "ru/labour-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "Праздник Весны и Труда",
+		Names:    map[string]string{"ru": "Праздник Весны и Труда", "en": "Spring and Labour Day"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt})
 
 	// VictoryDay represents Victory Day on 9-May
 	VictoryDay = &cal.Holiday{
+		ID:       "ru/victory-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "День Победы советского народа в Великой Отечественной войне 1941-1945 годов",
+		Names:    map[string]string{"ru": "День Победы советского народа в Великой Отечественной войне 1941-1945 годов", "en": "Victory Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      9,
@@ -74,7 +92,10 @@ var (
 
 	// RussiasDay represents Russia's Day on 12-Jun
 	RussiasDay = &cal.Holiday{
+		ID:       "ru/russias-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "День России",
+		Names:    map[string]string{"ru": "День России", "en": "Russia Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.June,
 		Day:      12,
@@ -84,7 +105,10 @@ var (
 
 	// UnionDay represents National Union Day on 4-Nov
 	UnionDay = &cal.Holiday{
+		ID:       "ru/union-day",
+		Source:   "Трудовой кодекс РФ, ст. 112",
 		Name:     "День народного едиснтва России",
+		Names:    map[string]string{"ru": "День народного едиснтва России", "en": "Unity Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.November,
 		Day:      4,
@@ -104,3 +128,12 @@ var (
 		UnionDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "RU",
+		Alpha3:   "RUS",
+		Name:     "Russia",
+		Holidays: Holidays,
+	})
+}
@@ -12,41 +12,95 @@ import (
 
 var (
 	// Nyarsdagen represents New Year's Day on 1-Jan
-	Nyarsdagen = aa.NewYear.Clone(&cal.Holiday{Name: "Nyårsdagen", Type: cal.ObservancePublic})
+	Nyarsdagen = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "se/nyarsdagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Nyårsdagen",
+		Names:  map[string]string{"sv": "Nyårsdagen", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// TrettondedagJul represents Epiphany on 6-Jan
-	TrettondedagJul = aa.Epiphany.Clone(&cal.Holiday{Name: "Trettondedag jul", Type: cal.ObservancePublic})
+	TrettondedagJul = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "se/trettondedag-jul",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Trettondedag jul",
+		Names:  map[string]string{"sv": "Trettondedag jul", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Langfredagen represents Good Friday on the Friday before Easter
-	Langfredagen = aa.GoodFriday.Clone(&cal.Holiday{Name: "Långfredagen", Type: cal.ObservancePublic})
+	Langfredagen = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "se/langfredagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Långfredagen",
+		Names:  map[string]string{"sv": "Långfredagen", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Paskdagen represents the day of Easter
-	Paskdagen = aa.Easter.Clone(&cal.Holiday{Name: "Påskdagen", Type: cal.ObservancePublic})
+	Paskdagen = aa.Easter.Clone(&cal.Holiday{
+		ID:     "se/paskdagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Påskdagen",
+		Names:  map[string]string{"sv": "Påskdagen", "en": "Easter Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AnnandagPask represents Easter Monday on the day after Easter
-	AnnandagPask = aa.EasterMonday.Clone(&cal.Holiday{Name: "Annandag påsk", Type: cal.ObservancePublic})
+	AnnandagPask = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "se/annandag-pask",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Annandag påsk",
+		Names:  map[string]string{"sv": "Annandag påsk", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// ForstaMaj represents Labour Day on 1-May
-	ForstaMaj = aa.WorkersDay.Clone(&cal.Holiday{Name: "Första maj", Type: cal.ObservancePublic})
+	ForstaMaj = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "se/forsta-maj",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Första maj",
+		Names:  map[string]string{"sv": "Första maj", "en": "May Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// KristiHimmelsfardsdag represents Ascension Day on the 39th day after Easter
-	KristiHimmelsfardsdag = aa.AscensionDay.Clone(&cal.Holiday{Name: "Kristi himmelsfärdsdag", Type: cal.ObservancePublic})
+	KristiHimmelsfardsdag = aa.AscensionDay.Clone(&cal.Holiday{
+		ID:     "se/kristi-himmelsfardsdag",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Kristi himmelsfärdsdag",
+		Names:  map[string]string{"sv": "Kristi himmelsfärdsdag", "en": "Ascension Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Pingstdagen represents Pentecost Sunday on the 49th day after Easter
-	Pingstdagen = aa.Pentecost.Clone(&cal.Holiday{Name: "Pingstdagen", Type: cal.ObservancePublic})
+	Pingstdagen = aa.Pentecost.Clone(&cal.Holiday{
+		ID:     "se/pingstdagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Pingstdagen",
+		Names:  map[string]string{"sv": "Pingstdagen", "en": "Whit Sunday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Nationaldagen represents National Day of Sweden on 6-Jun
 	Nationaldagen = &cal.Holiday{
-		Name:  "Sveriges nationaldag",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   6,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "se/nationaldagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Sveriges nationaldag",
+		Names:  map[string]string{"sv": "Sveriges nationaldag", "en": "National Day of Sweden"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    6,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Midsommarafton represents Midsummer's Eve on the day before Midsummer's Day
 	Midsommarafton = &cal.Holiday{
+		ID:      "se/midsommarafton",
+		Source:  "Semesterlag (1977:480) 3 §",
 		Name:    "Midsommarafton",
+		Names:   map[string]string{"sv": "Midsommarafton", "en": "Midsummer Eve"},
 		Type:    cal.ObservanceOther,
 		Month:   time.June,
 		Day:     19,
@@ -57,7 +111,10 @@ var (
 
 	// Midsommardagen represents Midsummer's Day on the first Saturday from 20-Jun
 	Midsommardagen = &cal.Holiday{
+		ID:      "se/midsommardagen",
+		Source:  "Lag (1989:253) om allmänna helgdagar",
 		Name:    "Midsommardagen",
+		Names:   map[string]string{"sv": "Midsommardagen", "en": "Midsummer Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.June,
 		Day:     20,
@@ -68,7 +125,10 @@ var (
 
 	// AllaHelgonsDag represents All Saints' Day on the first Saturday from 31-Oct
 	AllaHelgonsDag = &cal.Holiday{
+		ID:      "se/alla-helgons-dag",
+		Source:  "Lag (1989:253) om allmänna helgdagar",
 		Name:    "Alla helgons dag",
+		Names:   map[string]string{"sv": "Alla helgons dag", "en": "All Saints' Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Day:     31,
@@ -79,26 +139,44 @@ var (
 
 	// Julafton represents Christmas Eve on 24-Dec
 	Julafton = &cal.Holiday{
-		Name:  "Julafton",
-		Type:  cal.ObservanceOther,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "se/julafton",
+		Source: "Semesterlag (1977:480) 3 §",
+		Name:   "Julafton",
+		Names:  map[string]string{"sv": "Julafton", "en": "Christmas Eve"},
+		Type:   cal.ObservanceOther,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Juldagen represents Christmas Day on 25-Dec
-	Juldagen = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Juldagen", Type: cal.ObservancePublic})
+	Juldagen = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "se/juldagen",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Juldagen",
+		Names:  map[string]string{"sv": "Juldagen", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// AnnandagJul represents the second day of Christmas on 26-Dec
-	AnnandagJul = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Annandag jul", Type: cal.ObservancePublic})
+	AnnandagJul = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "se/annandag-jul",
+		Source: "Lag (1989:253) om allmänna helgdagar",
+		Name:   "Annandag jul",
+		Names:  map[string]string{"sv": "Annandag jul", "en": "Boxing Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Nyarsafton represents New Year's Eve on 31-Dec
 	Nyarsafton = &cal.Holiday{
-		Name:  "Nyårsafton",
-		Type:  cal.ObservanceOther,
-		Month: time.December,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "se/nyarsafton",
+		Source: "Semesterlag (1977:480) 3 §",
+		Name:   "Nyårsafton",
+		Names:  map[string]string{"sv": "Nyårsafton", "en": "New Year's Eve"},
+		Type:   cal.ObservanceOther,
+		Month:  time.December,
+		Day:    31,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays
@@ -119,3 +197,12 @@ var (
 		Nyarsafton,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "SE",
+		Alpha3:   "SWE",
+		Name:     "Sweden",
+		Holidays: Holidays,
+	})
+}
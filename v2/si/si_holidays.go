@@ -12,96 +12,159 @@ import (
 
 var (
 	// NovoLeto represents New Year's Day on 1-Jan.
-	NovoLeto = aa.NewYear.Clone(&cal.Holiday{Name: "novo leto", Type: cal.ObservancePublic})
+	NovoLeto = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "si/novo-leto",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "novo leto",
+		Names:  map[string]string{"sl": "novo leto", "en": "New Year's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// NovoLeto2 represents New Year's Day on 2-Jan.
 	NovoLeto2 = &cal.Holiday{
-		Name:  "novo leto",
-		Month: time.January,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/novo-leto-2",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "novo leto",
+		Names:  map[string]string{"sl": "novo leto", "en": "New Year's Day"},
+		Month:  time.January,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// PresernovDan represents Prešeren day on 8-Feb.
 	PresernovDan = &cal.Holiday{
-		Name:  "Prešernov dan",
-		Month: time.February,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/presernov-dan",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "Prešernov dan",
+		Names:  map[string]string{"sl": "Prešernov dan", "en": "Prešeren Day"},
+		Month:  time.February,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// DanUporaProtiOkupatorju represents Day of Uprising Against Occupation on 27-Apr.
 	DanUporaProtiOkupatorju = &cal.Holiday{
-		Name:  "Dan upora proti okupatorju",
-		Month: time.April,
-		Day:   27,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/dan-upora-proti-okupatorju",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "Dan upora proti okupatorju",
+		Names:  map[string]string{"sl": "Dan upora proti okupatorju", "en": "Day of Uprising Against Occupation"},
+		Month:  time.April,
+		Day:    27,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// VelikaNoc represents Easter.
 	VelikaNoc = &cal.Holiday{
+		ID:     "si/velika-noc",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
 		Name:   "velika noč",
+		Names:  map[string]string{"sl": "velika noč", "en": "Easter Sunday"},
 		Offset: 0,
 		Func:   cal.CalcEasterOffset,
 		Type:   cal.ObservancePublic,
 	}
 
 	// VelikonocniPonedeljek represents Easter Monday on the day after Easter.
-	VelikonocniPonedeljek = aa.EasterMonday.Clone(&cal.Holiday{Name: "velikonočni ponedeljek", Type: cal.ObservancePublic})
+	VelikonocniPonedeljek = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "si/velikonocni-ponedeljek",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "velikonočni ponedeljek",
+		Names:  map[string]string{"sl": "velikonočni ponedeljek", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// BinkostnaNedelja represents Pentecost Sunday (49 days after Easter).
 	BinkostnaNedelja = &cal.Holiday{
+		ID:     "si/binkostna-nedelja",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
 		Name:   "binkošti",
+		Names:  map[string]string{"sl": "binkošti", "en": "Whit Sunday"},
 		Offset: 49,
 		Func:   cal.CalcEasterOffset,
 	}
 
 	// PrviMaj represents International Workers' Day on 1-May.
-	PrviMaj = aa.WorkersDay.Clone(&cal.Holiday{Name: "praznik dela", Type: cal.ObservancePublic})
+	PrviMaj = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "si/prvi-maj",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "praznik dela",
+		Names:  map[string]string{"sl": "praznik dela", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DrugiMaj represents International Workers' Day on 2-May.
 	DrugiMaj = &cal.Holiday{
-		Name:  "praznik dela",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   2,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/drugi-maj",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "praznik dela",
+		Names:  map[string]string{"sl": "praznik dela", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    2,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// DanDrzavnosti represents National Day on 26-Oct.
 	DanDrzavnosti = &cal.Holiday{
-		Name:  "dan državnosti",
-		Type:  cal.ObservancePublic,
-		Month: time.June,
-		Day:   25,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/dan-drzavnosti",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "dan državnosti",
+		Names:  map[string]string{"sl": "dan državnosti", "en": "Statehood Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.June,
+		Day:    25,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// MarijinoVnebovzetje represents Assumption of Mary on 15-Aug.
-	MarijinoVnebovzetje = aa.AssumptionOfMary.Clone(&cal.Holiday{Name: "Marijino vnebovzetje", Type: cal.ObservancePublic})
+	MarijinoVnebovzetje = aa.AssumptionOfMary.Clone(&cal.Holiday{
+		ID:     "si/marijino-vnebovzetje",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "Marijino vnebovzetje",
+		Names:  map[string]string{"sl": "Marijino vnebovzetje", "en": "Assumption Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanReformacije represents All Saints' Day on 31-Oct.
 	DanReformacije = &cal.Holiday{
-		Name:  "dan reformacije",
-		Type:  cal.ObservancePublic,
-		Month: time.October,
-		Day:   31,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/dan-reformacije",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "dan reformacije",
+		Names:  map[string]string{"sl": "dan reformacije", "en": "Reformation Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.October,
+		Day:    31,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// DanSpominaNaMrtve represents All Saints' Day on 1-Nov.
-	DanSpominaNaMrtve = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "dan spomina na mrtve", Type: cal.ObservancePublic})
+	DanSpominaNaMrtve = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "si/dan-spomina-na-mrtve",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "dan spomina na mrtve",
+		Names:  map[string]string{"sl": "dan spomina na mrtve", "en": "Remembrance Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Bozic represents Christmas Day on 25-Dec.
-	Bozic = aa.ChristmasDay.Clone(&cal.Holiday{Name: "božič", Type: cal.ObservancePublic})
+	Bozic = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "si/bozic",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "božič",
+		Names:  map[string]string{"sl": "božič", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// DanSamostojnostiInEnotnosti represents Independence Day on 26-Dec.
 	DanSamostojnostiInEnotnosti = &cal.Holiday{
-		Name:  "dan samostojnosti in enotnosti",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   26,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "si/dan-samostojnosti-in-enotnosti",
+		Source: "Zakon o praznikih in dela prostih dnevih v Republiki Sloveniji",
+		Name:   "dan samostojnosti in enotnosti",
+		Names:  map[string]string{"sl": "dan samostojnosti in enotnosti", "en": "Independence and Unity Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    26,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Holidays provides a list of the standard national holidays.
@@ -123,3 +186,12 @@ var (
 		DanSamostojnostiInEnotnosti,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "SI",
+		Alpha3:   "SVN",
+		Name:     "Slovenia",
+		Holidays: Holidays,
+	})
+}
@@ -13,50 +13,92 @@ import (
 var (
 
 	// RepublicDay represents Republic Day on 1-Jan
-	RepublicDay = aa.NewYear.Clone(&cal.Holiday{Name: "Deň vzniku Slovenskej republiky", Type: cal.ObservancePublic})
+	RepublicDay = aa.NewYear.Clone(&cal.Holiday{
+		ID:     "sk/republic-day",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Deň vzniku Slovenskej republiky",
+		Names:  map[string]string{"sk": "Deň vzniku Slovenskej republiky", "en": "Day of the Establishment of the Slovak Republic"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Epiphany represents Epiphany on 6-Jan
-	Epiphany = aa.Epiphany.Clone(&cal.Holiday{Name: "Zjavenie Pána", Type: cal.ObservancePublic})
+	Epiphany = aa.Epiphany.Clone(&cal.Holiday{
+		ID:     "sk/epiphany",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Zjavenie Pána",
+		Names:  map[string]string{"sk": "Zjavenie Pána", "en": "Epiphany"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Veľkonočný piatok", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "sk/good-friday",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Veľkonočný piatok",
+		Names:  map[string]string{"sk": "Veľkonočný piatok", "en": "Good Friday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// EasterMonday represents Easter Monday - the day after Easter
-	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{Name: "Veľkonočný pondelok", Type: cal.ObservancePublic})
+	EasterMonday = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "sk/easter-monday",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Veľkonočný pondelok",
+		Names:  map[string]string{"sk": "Veľkonočný pondelok", "en": "Easter Monday"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// LabourDay represents Labour Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Sviatok práce", Type: cal.ObservancePublic})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:     "sk/labour-day",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Sviatok práce",
+		Names:  map[string]string{"sk": "Sviatok práce", "en": "Labour Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Liberation represents Liberation Day on 8-May
 	Liberation = &cal.Holiday{
-		Name:  "Deň víťazstva nad fašizmom",
-		Type:  cal.ObservancePublic,
-		Month: time.May,
-		Day:   8,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/liberation",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Deň víťazstva nad fašizmom",
+		Names:  map[string]string{"sk": "Deň víťazstva nad fašizmom", "en": "Day of Victory over Fascism"},
+		Type:   cal.ObservancePublic,
+		Month:  time.May,
+		Day:    8,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SaintsCyril represents St. Cyril and Methodius Day on 5-Jul
 	SaintsCyril = &cal.Holiday{
-		Name:  "Sviatok svätého Cyrila a Metoda",
-		Type:  cal.ObservancePublic,
-		Month: time.July,
-		Day:   5,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/saints-cyril",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Sviatok svätého Cyrila a Metoda",
+		Names:  map[string]string{"sk": "Sviatok svätého Cyrila a Metoda", "en": "Saints Cyril and Methodius Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.July,
+		Day:    5,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// SNP represents Slovak National Uprising Anniversary on 29-Aug
 	SNP = &cal.Holiday{
-		Name:  "Výročie Slovenského národného povstania",
-		Type:  cal.ObservancePublic,
-		Month: time.August,
-		Day:   29,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/snp",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Výročie Slovenského národného povstania",
+		Names:  map[string]string{"sk": "Výročie Slovenského národného povstania", "en": "Slovak National Uprising Anniversary"},
+		Type:   cal.ObservancePublic,
+		Month:  time.August,
+		Day:    29,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// Constitution represents Constitution Day on 1-Sep
 	Constitution = &cal.Holiday{
+		ID:      "sk/constitution",
+		Source:  "Zákon č. 241/1993 Z. z.",
 		Name:    "Deň Ústavy Slovenskej republiky",
+		Names:   map[string]string{"sk": "Deň Ústavy Slovenskej republiky", "en": "Constitution Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.September,
 		Day:     1,
@@ -66,39 +108,66 @@ var (
 
 	// LadyOfSorrows represents Day of Our Lady of the Seven Sorrows on 15-Sep
 	LadyOfSorrows = &cal.Holiday{
-		Name:  "Sviatok Panny Márie Sedembolestnej, patrónky Slovenska",
-		Type:  cal.ObservancePublic,
-		Month: time.September,
-		Day:   15,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/lady-of-sorrows",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Sviatok Panny Márie Sedembolestnej, patrónky Slovenska",
+		Names:  map[string]string{"sk": "Sviatok Panny Márie Sedembolestnej, patrónky Slovenska", "en": "Our Lady of Seven Sorrows"},
+		Type:   cal.ObservancePublic,
+		Month:  time.September,
+		Day:    15,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// AllSaints represents All Saints' Day on 1-Nov
-	AllSaints = aa.AllSaintsDay.Clone(&cal.Holiday{Name: "Sviatok všetkých svätých", Type: cal.ObservancePublic})
+	AllSaints = aa.AllSaintsDay.Clone(&cal.Holiday{
+		ID:     "sk/all-saints",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Sviatok všetkých svätých",
+		Names:  map[string]string{"sk": "Sviatok všetkých svätých", "en": "All Saints' Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Freedom represents Struggle for Freedom and Democracy Day on 17-Nov
 	Freedom = &cal.Holiday{
-		Name:  "Deň boja za slobodu a demokraciu",
-		Type:  cal.ObservancePublic,
-		Month: time.November,
-		Day:   17,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/freedom",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Deň boja za slobodu a demokraciu",
+		Names:  map[string]string{"sk": "Deň boja za slobodu a demokraciu", "en": "Struggle for Freedom and Democracy Day"},
+		Type:   cal.ObservancePublic,
+		Month:  time.November,
+		Day:    17,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasEve represents Christmas Eve on 24-Dec
 	ChristmasEve = &cal.Holiday{
-		Name:  "Štedrý deň",
-		Type:  cal.ObservancePublic,
-		Month: time.December,
-		Day:   24,
-		Func:  cal.CalcDayOfMonth,
+		ID:     "sk/christmas-eve",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Štedrý deň",
+		Names:  map[string]string{"sk": "Štedrý deň", "en": "Christmas Eve"},
+		Type:   cal.ObservancePublic,
+		Month:  time.December,
+		Day:    24,
+		Func:   cal.CalcDayOfMonth,
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Prvý sviatok vianočný", Type: cal.ObservancePublic})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "sk/christmas-day",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Prvý sviatok vianočný",
+		Names:  map[string]string{"sk": "Prvý sviatok vianočný", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// SaintStephen represents Boxing Day on 26-Dec
-	SaintStephen = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Druhý sviatok vianočný", Type: cal.ObservancePublic})
+	SaintStephen = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "sk/saint-stephen",
+		Source: "Zákon č. 241/1993 Z. z.",
+		Name:   "Druhý sviatok vianočný",
+		Names:  map[string]string{"sk": "Druhý sviatok vianočný", "en": "St. Stephen's Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -119,3 +188,12 @@ var (
 		SaintStephen,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "SK",
+		Alpha3:   "SVK",
+		Name:     "Slovakia",
+		Holidays: Holidays,
+	})
+}
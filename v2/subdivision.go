@@ -0,0 +1,43 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "strings"
+
+// Subdivision represents a region of a country, such as a state, province or
+// canton, that observes its own list of holidays.
+type Subdivision struct {
+	Code     string     // ISO 3166-2 code, e.g. "DE-BY"
+	Name     string     // name of the subdivision
+	Holidays []*Holiday // holidays observed in the subdivision
+}
+
+// NewCalendar creates a new Calendar with the subdivision's holidays.
+func (s *Subdivision) NewCalendar() *Calendar {
+	c := &Calendar{Name: s.Code, Description: s.Name}
+	c.AddHoliday(s.Holidays...)
+	return c
+}
+
+// NewBusinessCalendar creates a new BusinessCalendar with the subdivision's
+// holidays and the defaults of NewBusinessCalendar.
+func (s *Subdivision) NewBusinessCalendar() *BusinessCalendar {
+	c := NewBusinessCalendar()
+	c.Name = s.Code
+	c.Description = s.Name
+	c.AddHoliday(s.Holidays...)
+	return c
+}
+
+// FindSubdivision reports the subdivision in the list with the given ISO
+// 3166-2 code. Codes are matched without regard to case.
+//
+// If no subdivision matches, nil is returned.
+func FindSubdivision(subdivisions []*Subdivision, code string) *Subdivision {
+	for _, s := range subdivisions {
+		if strings.EqualFold(s.Code, code) {
+			return s
+		}
+	}
+	return nil
+}
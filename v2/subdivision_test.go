@@ -0,0 +1,47 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubdivision(t *testing.T) {
+	hol := &Holiday{Name: "Test", Month: time.March, Day: 2, Func: CalcDayOfMonth}
+	subs := []*Subdivision{
+		{Code: "XX-AA", Name: "Region A", Holidays: []*Holiday{hol}},
+		{Code: "XX-BB", Name: "Region B"},
+	}
+
+	tests := []struct {
+		code string
+		want *Subdivision
+	}{
+		{"XX-AA", subs[0]},
+		{"xx-bb", subs[1]},
+		{"XX-CC", nil},
+		{"", nil},
+	}
+	for _, test := range tests {
+		if got := FindSubdivision(subs, test.code); got != test.want {
+			t.Errorf("%q: got: %v; want: %v", test.code, got, test.want)
+		}
+	}
+
+	c := subs[0].NewCalendar()
+	if c.Name != "XX-AA" || c.Description != "Region A" || len(c.Holidays) != 1 {
+		t.Errorf("bad calendar: %s, %s, %v", c.Name, c.Description, c.Holidays)
+	}
+	if act, _, _ := c.IsHoliday(d(2021, 3, 2)); !act {
+		t.Errorf("expected holiday on 2021-03-02")
+	}
+
+	b := subs[0].NewBusinessCalendar()
+	if b.Name != "XX-AA" || b.Description != "Region A" || len(b.Holidays) != 1 {
+		t.Errorf("bad business calendar: %s, %s, %v", b.Name, b.Description, b.Holidays)
+	}
+	if b.IsWorkday(d(2021, 3, 2)) || !b.IsWorkday(d(2021, 3, 3)) {
+		t.Errorf("bad business calendar workdays")
+	}
+}
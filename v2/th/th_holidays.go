@@ -29,14 +29,18 @@ var (
 
 	// NewYear represents New Year's Day on 1 Jan
 	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "th/new-year",
 		Name:     "วันขึ้นปีใหม่",
+		Names:    map[string]string{"th": "วันขึ้นปีใหม่", "en": "New Year's Day"},
 		Type:     cal.ObservancePublic,
 		Observed: weekendAlt,
 	})
 
 	// ChakriDay represents Chakri Dynasty Memorial Day on 6 Apr
 	ChakriDay = &cal.Holiday{
+		ID:       "th/chakri-day",
 		Name:     "วันจักรี",
+		Names:    map[string]string{"th": "วันจักรี", "en": "Chakri Memorial Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      6,
@@ -46,7 +50,9 @@ var (
 
 	// ElderlyDay represents Songkran and Elderly National Day on 13 Apr
 	ElderlyDay = &cal.Holiday{
+		ID:       "th/elderly-day",
 		Name:     "วันมหาสงกรานต์ / วันผู้สูงอายุ",
+		Names:    map[string]string{"th": "วันมหาสงกรานต์ / วันผู้สูงอายุ", "en": "Songkran / National Elderly Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      13,
@@ -56,7 +62,9 @@ var (
 
 	// FamilyDay represents Songkran and Family National Day on 14 Apr
 	FamilyDay = &cal.Holiday{
+		ID:       "th/family-day",
 		Name:     "วันสงกรานต์ / วันครอบครัวไทย",
+		Names:    map[string]string{"th": "วันสงกรานต์ / วันครอบครัวไทย", "en": "Songkran / Family Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      14,
@@ -66,7 +74,9 @@ var (
 
 	// ThaiNewYear represents Songkran on 15 Apr
 	ThaiNewYear = &cal.Holiday{
+		ID:       "th/thai-new-year",
 		Name:     "วันสงกรานต์",
+		Names:    map[string]string{"th": "วันสงกรานต์", "en": "Songkran"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      15,
@@ -84,7 +94,9 @@ var (
 	// LaborDay represents international worker's day on 1 May
 	LaborDay = aa.WorkersDay.Clone(
 		&cal.Holiday{
+			ID:       "th/labor-day",
 			Name:     "วันแรงงาน",
+			Names:    map[string]string{"th": "วันแรงงาน", "en": "Labour Day"},
 			Type:     cal.ObservanceBank,
 			Observed: weekendAlt,
 		},
@@ -92,7 +104,9 @@ var (
 
 	// CoronationDay represents King Rama X's Coronation Day on 4 May
 	CoronationDay = &cal.Holiday{
+		ID:        "th/coronation-day",
 		Name:      "วันฉัตรมงคล",
+		Names:     map[string]string{"th": "วันฉัตรมงคล", "en": "Coronation Day"},
 		Type:      cal.ObservancePublic,
 		Month:     time.May,
 		Day:       4,
@@ -103,7 +117,9 @@ var (
 
 	// QueensBirthday represents Queen Suthida's birthday on 3 June
 	QueensBirthday = &cal.Holiday{
+		ID:        "th/queens-birthday",
 		Name:      "วันเฉลิมพระชนมพรรษาสมเด็จพระนางเจ้าฯ พระบรมราชินี",
+		Names:     map[string]string{"th": "วันเฉลิมพระชนมพรรษาสมเด็จพระนางเจ้าฯ พระบรมราชินี", "en": "Queen's Birthday"},
 		Type:      cal.ObservancePublic,
 		Month:     time.June,
 		Day:       3,
@@ -114,7 +130,9 @@ var (
 
 	// KingsBirthday represents King Rama X's birthday on 28 July
 	KingsBirthday = &cal.Holiday{
+		ID:        "th/kings-birthday",
 		Name:      "วันเฉลิมพระชนมพรรษาพระบาทสมเด็จพระเจ้าอยู่หัว",
+		Names:     map[string]string{"th": "วันเฉลิมพระชนมพรรษาพระบาทสมเด็จพระเจ้าอยู่หัว", "en": "King's Birthday"},
 		Type:      cal.ObservancePublic,
 		Month:     time.July,
 		Day:       28,
@@ -125,7 +143,9 @@ var (
 
 	// MothersDay represents Mother National Day on 12 Aug
 	MothersDay = &cal.Holiday{
+		ID:       "th/mothers-day",
 		Name:     "วันแม่แห่งชาติ",
+		Names:    map[string]string{"th": "วันแม่แห่งชาติ", "en": "Mother's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.August,
 		Day:      12,
@@ -135,7 +155,9 @@ var (
 
 	// KingBhumibolDay represents King Bhumibol Memorial Day on 13 Oct
 	KingBhumibolDay = &cal.Holiday{
+		ID:        "th/king-bhumibol-day",
 		Name:      "วันคล้ายวันสวรรคต พระบาทสมเด็จพระปรมินทรมหาภูมิพลอดุลยเดชมหาราช",
+		Names:     map[string]string{"th": "วันคล้ายวันสวรรคต พระบาทสมเด็จพระปรมินทรมหาภูมิพลอดุลยเดชมหาราช", "en": "King Bhumibol Adulyadej Memorial Day"},
 		Type:      cal.ObservancePublic,
 		Month:     time.October,
 		Day:       13,
@@ -146,7 +168,9 @@ var (
 
 	// KingChulalongkornDay represents King Chulalongkorn Memorial Day on 23 Oct
 	KingChulalongkornDay = &cal.Holiday{
+		ID:       "th/king-chulalongkorn-day",
 		Name:     "วันปิยมหาราช",
+		Names:    map[string]string{"th": "วันปิยมหาราช", "en": "Chulalongkorn Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.October,
 		Day:      23,
@@ -156,7 +180,9 @@ var (
 
 	// FathersDay represents Father's National Day on 5 Dec
 	FathersDay = &cal.Holiday{
+		ID:       "th/fathers-day",
 		Name:     "วันพ่อแห่งชาติ",
+		Names:    map[string]string{"th": "วันพ่อแห่งชาติ", "en": "Father's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      5,
@@ -166,7 +192,9 @@ var (
 
 	// ConstitutionDay represents Constitution Day on 10 Dec
 	ConstitutionDay = &cal.Holiday{
+		ID:       "th/constitution-day",
 		Name:     "วันรัฐธรรมนูญ",
+		Names:    map[string]string{"th": "วันรัฐธรรมนูญ", "en": "Constitution Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      10,
@@ -176,7 +204,9 @@ var (
 
 	// NewYearEve represents NewYear Eve on 31 Dec
 	NewYearEve = &cal.Holiday{
+		ID:    "th/new-year-eve",
 		Name:  "วันสิ้นปี",
+		Names: map[string]string{"th": "วันสิ้นปี", "en": "New Year's Eve"},
 		Type:  cal.ObservancePublic,
 		Month: time.December,
 		Day:   31,
@@ -199,3 +229,12 @@ var (
 	}
 	Holidays = append(holidays, SongKranDays...)
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "TH",
+		Alpha3:   "THA",
+		Name:     "Thailand",
+		Holidays: Holidays,
+	})
+}
@@ -20,11 +20,21 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "Новий Рік", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "ua/new-year",
+		Source:   "Кодекс законів про працю України, ст. 73",
+		Name:     "Новий Рік",
+		Names:    map[string]string{"uk": "Новий Рік", "en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// OrthodoxChristmas represents Orthodox Christmas on 7-Jan
 	OrthodoxChristmas = &cal.Holiday{
+		ID:       "ua/orthodox-christmas",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "Різдво",
+		Names:    map[string]string{"uk": "Різдво", "en": "Orthodox Christmas"},
 		Type:     cal.ObservancePublic,
 		Month:    time.January,
 		Day:      7,
@@ -34,7 +44,10 @@ var (
 
 	// WomensDay represents International Women's Day 8-Mar
 	WomensDay = &cal.Holiday{
+		ID:       "ua/womens-day",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "Міжнародний жіночий день",
+		Names:    map[string]string{"uk": "Міжнародний жіночий день", "en": "International Women's Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      8,
@@ -44,7 +57,10 @@ var (
 
 	// OrthodoxEasterMonday represents Orthodox Easter Monday on the day after Julian Easter
 	OrthodoxEasterMonday = &cal.Holiday{
+		ID:     "ua/orthodox-easter-monday",
+		Source: "Кодекс законів про працю України, ст. 73",
 		Name:   "Великдень",
+		Names:  map[string]string{"uk": "Великдень", "en": "Easter Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 1,
 		Julian: true,
@@ -52,11 +68,21 @@ var (
 	}
 
 	// LabourDay represents Labour Day on 1-May
-	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "День праці", Type: cal.ObservancePublic, Observed: weekendAlt})
+	LabourDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:       "ua/labour-day",
+		Source:   "Кодекс законів про працю України, ст. 73",
+		Name:     "День праці",
+		Names:    map[string]string{"uk": "День праці", "en": "Labour Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// LabourDay2 represents second Labour Day on 2-May
 	LabourDay2 = &cal.Holiday{
+		ID:       "ua/labour-day-2",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "День праці",
+		Names:    map[string]string{"uk": "День праці", "en": "Labour Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      2,
@@ -67,7 +93,10 @@ var (
 
 	// VictoryDay represents Victory Day on 9-May
 	VictoryDay = &cal.Holiday{
+		ID:       "ua/victory-day",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "День перемоги над нацизмом у Другій світовій війні",
+		Names:    map[string]string{"uk": "День перемоги над нацизмом у Другій світовій війні", "en": "Victory Day over Nazism in World War II"},
 		Type:     cal.ObservancePublic,
 		Month:    time.May,
 		Day:      9,
@@ -77,7 +106,10 @@ var (
 
 	// OrthodoxPentecostMonday represents the day after Orthodox Pentecost, 49 days after Easter
 	OrthodoxPentecostMonday = &cal.Holiday{
+		ID:     "ua/orthodox-pentecost-monday",
+		Source: "Кодекс законів про працю України, ст. 73",
 		Name:   "Трійця",
+		Names:  map[string]string{"uk": "Трійця", "en": "Whit Monday"},
 		Type:   cal.ObservancePublic,
 		Offset: 49,
 		Julian: true,
@@ -86,7 +118,10 @@ var (
 
 	// ConstitutionDay represents Constitution Day on 9-May
 	ConstitutionDay = &cal.Holiday{
+		ID:       "ua/constitution-day",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "День Конституції",
+		Names:    map[string]string{"uk": "День Конституції", "en": "Constitution Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.June,
 		Day:      28,
@@ -96,7 +131,10 @@ var (
 
 	// IndependenceDay represents Independence Day on 24-Aug
 	IndependenceDay = &cal.Holiday{
+		ID:       "ua/independence-day",
+		Source:   "Кодекс законів про працю України, ст. 73",
 		Name:     "День Незалежності",
+		Names:    map[string]string{"uk": "День Незалежності", "en": "Independence Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.August,
 		Day:      24,
@@ -106,7 +144,10 @@ var (
 
 	// DefenderOfUkraineDay represents Defender of Ukraine Day on 14-Oct
 	DefenderOfUkraineDay = &cal.Holiday{
+		ID:        "ua/defender-of-ukraine-day",
+		Source:    "Кодекс законів про працю України, ст. 73",
 		Name:      "День захисника України",
+		Names:     map[string]string{"uk": "День захисника України", "en": "Defenders of Ukraine Day"},
 		Type:      cal.ObservancePublic,
 		StartYear: 2015,
 		Month:     time.October,
@@ -116,7 +157,13 @@ var (
 	}
 
 	// CatholicChristmas represents Christmas Day on 25-Dec
-	CatholicChristmas = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Різдво", Type: cal.ObservancePublic})
+	CatholicChristmas = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:     "ua/catholic-christmas",
+		Source: "Кодекс законів про працю України, ст. 73",
+		Name:   "Різдво",
+		Names:  map[string]string{"uk": "Різдво", "en": "Christmas Day"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -134,3 +181,12 @@ var (
 		CatholicChristmas,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "UA",
+		Alpha3:   "UKR",
+		Name:     "Ukraine",
+		Holidays: Holidays,
+	})
+}
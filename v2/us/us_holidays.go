@@ -20,11 +20,21 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "us/new-year",
+		Source:   "5 U.S.C. 6103(a)",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// MlkDay represents Martin Luther King Jr. Day on the 3rd Monday in January
 	MlkDay = &cal.Holiday{
+		ID:      "us/mlk-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Martin Luther King Jr. Day",
+		Names:   map[string]string{"en": "Martin Luther King Jr. Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.January,
 		Weekday: time.Monday,
@@ -34,7 +44,10 @@ var (
 
 	// PresidentsDay represents Presidents' Day on the 3rd Monday in February
 	PresidentsDay = &cal.Holiday{
+		ID:      "us/presidents-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Presidents' Day",
+		Names:   map[string]string{"en": "Presidents' Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.February,
 		Weekday: time.Monday,
@@ -44,7 +57,10 @@ var (
 
 	// MemorialDay represents Memorial Day on the last Monday in May
 	MemorialDay = &cal.Holiday{
+		ID:      "us/memorial-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Memorial Day",
+		Names:   map[string]string{"en": "Memorial Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.May,
 		Weekday: time.Monday,
@@ -54,7 +70,10 @@ var (
 
 	// Juneteenth represents Juneteenth on June 19th
 	Juneteenth = &cal.Holiday{
+		ID:        "us/juneteenth",
+		Source:    "5 U.S.C. 6103(a)",
 		Name:      "Juneteenth",
+		Names:     map[string]string{"en": "Juneteenth"},
 		Type:      cal.ObservancePublic,
 		Month:     time.June,
 		Day:       19,
@@ -65,7 +84,10 @@ var (
 
 	// IndependenceDay represents Independence Day on 4-Jul
 	IndependenceDay = &cal.Holiday{
+		ID:       "us/independence-day",
+		Source:   "5 U.S.C. 6103(a)",
 		Name:     "Independence Day",
+		Names:    map[string]string{"en": "Independence Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.July,
 		Day:      4,
@@ -75,7 +97,10 @@ var (
 
 	// LaborDay represents Labor Day on the first Monday in September
 	LaborDay = &cal.Holiday{
+		ID:      "us/labor-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Labor Day",
+		Names:   map[string]string{"en": "Labor Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.September,
 		Weekday: time.Monday,
@@ -85,7 +110,10 @@ var (
 
 	// ColumbusDay represents Columbus Day on the second Monday in October
 	ColumbusDay = &cal.Holiday{
+		ID:      "us/columbus-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Columbus Day",
+		Names:   map[string]string{"en": "Columbus Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.October,
 		Weekday: time.Monday,
@@ -95,7 +123,10 @@ var (
 
 	// VeteransDay represents Veterans Day on 11-Nov
 	VeteransDay = &cal.Holiday{
+		ID:       "us/veterans-day",
+		Source:   "5 U.S.C. 6103(a)",
 		Name:     "Veterans Day",
+		Names:    map[string]string{"en": "Veterans Day"},
 		Type:     cal.ObservancePublic,
 		Month:    time.November,
 		Day:      11,
@@ -105,7 +136,10 @@ var (
 
 	// ThanksgivingDay represents Thanksgiving Day on the fourth Thursday in November
 	ThanksgivingDay = &cal.Holiday{
+		ID:      "us/thanksgiving-day",
+		Source:  "5 U.S.C. 6103(a)",
 		Name:    "Thanksgiving Day",
+		Names:   map[string]string{"en": "Thanksgiving Day"},
 		Type:    cal.ObservancePublic,
 		Month:   time.November,
 		Weekday: time.Thursday,
@@ -115,7 +149,9 @@ var (
 
 	// DayAfterThanksgivingDay represents the day after Thanksgiving Day on the fourth Friday in November
 	DayAfterThanksgivingDay = &cal.Holiday{
+		ID:         "us/day-after-thanksgiving-day",
 		Name:       "Day After Thanksgiving Day",
+		Names:      map[string]string{"en": "Day After Thanksgiving Day"},
 		Type:       cal.ObservancePublic,
 		Month:      time.November,
 		Weekday:    time.Thursday,
@@ -125,7 +161,14 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on the 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "us/christmas-day",
+		Source:   "5 U.S.C. 6103(a)",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// Holidays provides a list of the standard national holidays
 	Holidays = []*cal.Holiday{
@@ -142,3 +185,12 @@ var (
 		ChristmasDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "US",
+		Alpha3:   "USA",
+		Name:     "United States of America",
+		Holidays: Holidays,
+	})
+}
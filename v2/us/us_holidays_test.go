@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/caltest"
 )
 
 func d(y, m, d int) time.Time {
@@ -138,3 +139,7 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestFixture(t *testing.T) {
+	caltest.CheckFixture(t, "US", "testdata/holidays.csv")
+}
@@ -0,0 +1,207 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Problem identifies a kind of mistake found in holiday definitions.
+type Problem uint8
+
+// Allowed values for Problem
+const (
+	ProblemNoFunc           Problem = iota // Func is nil so the holiday never occurs
+	ProblemYearRange                       // StartYear is after EndYear so the holiday never occurs
+	ProblemExceptYear                      // an Except year is outside the years the holiday is observed
+	ProblemNoDate                          // Func returns the zero time in a year the holiday is observed
+	ProblemObservedMonth                   // the observed date is in a different month than the actual date
+	ProblemDuplicateDate                   // two holidays occur on the same date in every year they share
+	ProblemDuplicateHoliday                // the same holiday is in a list more than once
+	ProblemSameDate                        // two holidays occur on the same date in some of the years they share
+)
+
+// String returns the name of the problem.
+func (p Problem) String() string {
+	switch p {
+	case ProblemNoFunc:
+		return "no func"
+	case ProblemYearRange:
+		return "year range"
+	case ProblemExceptYear:
+		return "except year"
+	case ProblemNoDate:
+		return "no date"
+	case ProblemObservedMonth:
+		return "observed month"
+	case ProblemDuplicateDate:
+		return "duplicate date"
+	case ProblemDuplicateHoliday:
+		return "duplicate holiday"
+	case ProblemSameDate:
+		return "same date"
+	}
+	return "unknown"
+}
+
+// Diagnostic describes a problem found by validating holiday definitions.
+type Diagnostic struct {
+	Problem Problem  // the kind of problem
+	Holiday *Holiday // the holiday with the problem
+	Other   *Holiday // the other holiday involved, if any
+	Year    int      // the first year the problem occurs in; 0 if it doesn't depend on the year
+	Message string   // description of the problem
+	Warning bool     // the problem may be intentional, such as holidays that coincide in some years
+}
+
+// String returns the diagnostic as a single line naming the holiday.
+func (d Diagnostic) String() string {
+	name := d.Holiday.ID
+	if name == "" {
+		name = d.Holiday.Name
+	}
+	return fmt.Sprintf("%s: %s: %s", name, d.Problem, d.Message)
+}
+
+// Validate checks the holiday definition for mistakes that would otherwise
+// go unnoticed, calculating its dates for each year from startYear to endYear
+// inclusive. Each problem is reported once, for the first year it occurs in.
+//
+// If no problems are found, nil is returned.
+func (h *Holiday) Validate(startYear, endYear int) []Diagnostic {
+	var r []Diagnostic
+	report := func(p Problem, year int, format string, a ...interface{}) {
+		warn := p == ProblemNoDate || p == ProblemObservedMonth
+		r = append(r, Diagnostic{Problem: p, Holiday: h, Year: year, Message: fmt.Sprintf(format, a...), Warning: warn})
+	}
+
+	if h.Func == nil {
+		report(ProblemNoFunc, 0, "Func is nil")
+	}
+	if h.StartYear > 0 && h.EndYear > 0 && h.StartYear > h.EndYear {
+		report(ProblemYearRange, 0, "StartYear %d is after EndYear %d", h.StartYear, h.EndYear)
+	}
+	for _, ex := range h.Except {
+		if (h.StartYear > 0 && ex < h.StartYear) || (h.EndYear > 0 && ex > h.EndYear) {
+			report(ProblemExceptYear, 0, "Except year %d is outside %d-%d", ex, h.StartYear, h.EndYear)
+		}
+	}
+	if h.Func == nil {
+		return r
+	}
+
+	var noDate, obsMonth bool
+	for year := startYear; year <= endYear; year++ {
+		if !h.activeIn(year) {
+			continue
+		}
+		act, obs := h.CalcIn(year, time.UTC)
+		if act.IsZero() {
+			if !noDate {
+				noDate = true
+				report(ProblemNoDate, year, "no date in %d", year)
+			}
+			continue
+		}
+		if !obsMonth && obs.Month() != act.Month() {
+			obsMonth = true
+			report(ProblemObservedMonth, year, "%s is observed on %s",
+				act.Format("2006-01-02"), obs.Format("2006-01-02"))
+		}
+	}
+	return r
+}
+
+// activeIn reports whether the holiday is observed in the given year
+// according to its StartYear, EndYear and Except fields.
+func (h *Holiday) activeIn(year int) bool {
+	if (h.StartYear > 0 && year < h.StartYear) || (h.EndYear > 0 && year > h.EndYear) {
+		return false
+	}
+	for _, ex := range h.Except {
+		if year == ex {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateHolidays checks each of the holidays as with Holiday.Validate and
+// also checks the list as a whole for the years from startYear to endYear:
+//
+//   - a holiday that is in the list more than once is reported as an error
+//   - two holidays that occur on the same date in every year they are both
+//     observed, which usually means a holiday was added twice under different
+//     names, are reported as an error
+//   - two holidays that occur on the same date in some years, such as a fixed
+//     holiday and one based on Easter, are reported as a warning
+//
+// If no problems are found, nil is returned.
+func ValidateHolidays(startYear, endYear int, holidays ...*Holiday) []Diagnostic {
+	var r []Diagnostic
+	first := make(map[*Holiday]int)
+	for i, h := range holidays {
+		if j, ok := first[h]; ok {
+			r = append(r, Diagnostic{Problem: ProblemDuplicateHoliday, Holiday: h,
+				Message: fmt.Sprintf("listed at positions %d and %d", j, i)})
+			continue
+		}
+		first[h] = i
+		r = append(r, h.Validate(startYear, endYear)...)
+	}
+
+	for i, h := range holidays {
+		if first[h] != i {
+			continue
+		}
+		for j, o := range holidays[i+1:] {
+			if first[o] != i+1+j {
+				continue
+			}
+			if d, ok := sameDate(h, o, startYear, endYear); ok {
+				r = append(r, d)
+			}
+		}
+	}
+	return r
+}
+
+// sameDate compares the actual dates of two holidays in the years from
+// startYear to endYear and reports a diagnostic if they coincide in any year.
+func sameDate(h, o *Holiday, startYear, endYear int) (Diagnostic, bool) {
+	var first, shared, same int
+	for year := startYear; year <= endYear; year++ {
+		a1, _ := h.CalcIn(year, time.UTC)
+		a2, _ := o.CalcIn(year, time.UTC)
+		if a1.IsZero() || a2.IsZero() {
+			continue
+		}
+		shared++
+		if a1.Equal(a2) {
+			if same == 0 {
+				first = year
+			}
+			same++
+		}
+	}
+	switch {
+	case same == 0:
+		return Diagnostic{}, false
+	case same == shared:
+		return Diagnostic{Problem: ProblemDuplicateDate, Holiday: h, Other: o, Year: first,
+			Message: fmt.Sprintf("occurs on the same date as %s in every year", o.Name)}, true
+	}
+	msg := fmt.Sprintf("occurs on the same date as %s in %d", o.Name, first)
+	if same > 1 {
+		msg += fmt.Sprintf(" and %d other years", same-1)
+	}
+	return Diagnostic{Problem: ProblemSameDate, Holiday: h, Other: o, Year: first, Message: msg, Warning: true}, true
+}
+
+// Validate checks the calendar's holidays as with ValidateHolidays.
+//
+// If no problems are found, nil is returned.
+func (c *Calendar) Validate(startYear, endYear int) []Diagnostic {
+	return ValidateHolidays(startYear, endYear, c.Holidays...)
+}
@@ -0,0 +1,135 @@
+// (c) Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	newYear := &Holiday{ID: "xx/new-year", Name: "New Year", Month: time.January, Day: 1, Func: CalcDayOfMonth}
+	noFunc := &Holiday{Name: "No Func", Month: time.January, Day: 1}
+	badRange := newYear.Clone(&Holiday{ID: "xx/bad-range", StartYear: 2020, EndYear: 2010})
+	badExcept := newYear.Clone(&Holiday{ID: "xx/bad-except", StartYear: 2000, EndYear: 2010, Except: []int{1999, 2005, 2011}})
+	noDate := &Holiday{ID: "xx/no-date", Func: func(h *Holiday, year int, loc *time.Location) time.Time {
+		if year%2 == 0 {
+			return time.Time{}
+		}
+		return time.Date(year, time.March, 1, 0, 0, 0, 0, loc)
+	}}
+	obsMonth := newYear.Clone(&Holiday{ID: "xx/obs-month", Observed: []AltDay{{Day: time.Saturday, Offset: -1}, {Day: time.Sunday, Offset: 1}}})
+
+	tests := []struct {
+		h    *Holiday
+		want []Diagnostic
+	}{
+		{newYear, nil},
+		{newYear.Clone(&Holiday{StartYear: 2010, EndYear: 2030, Except: []int{2020}}), nil},
+		{noFunc, []Diagnostic{
+			{ProblemNoFunc, noFunc, nil, 0, "Func is nil", false},
+		}},
+		{badRange, []Diagnostic{
+			{ProblemYearRange, badRange, nil, 0, "StartYear 2020 is after EndYear 2010", false},
+		}},
+		{badExcept, []Diagnostic{
+			{ProblemExceptYear, badExcept, nil, 0, "Except year 1999 is outside 2000-2010", false},
+			{ProblemExceptYear, badExcept, nil, 0, "Except year 2011 is outside 2000-2010", false},
+		}},
+		{noDate, []Diagnostic{
+			{ProblemNoDate, noDate, nil, 2016, "no date in 2016", true},
+		}},
+		{obsMonth, []Diagnostic{
+			{ProblemObservedMonth, obsMonth, nil, 2022, "2022-01-01 is observed on 2021-12-31", true},
+		}},
+	}
+
+	for i, test := range tests {
+		if got := test.h.Validate(2015, 2025); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got: %v; want: %v", i, got, test.want)
+		}
+	}
+}
+
+func TestValidateHolidays(t *testing.T) {
+	newYear := &Holiday{ID: "xx/new-year", Name: "New Year", Month: time.January, Day: 1, Func: CalcDayOfMonth}
+	dup := newYear.Clone(&Holiday{ID: "xx/dup", Name: "Duplicate", StartYear: 2020})
+	easter := &Holiday{ID: "xx/easter", Name: "Easter", Func: CalcEasterOffset}
+	april := &Holiday{ID: "xx/april", Name: "April 30", Month: time.April, Day: 30, Func: CalcDayOfMonth}
+	march := &Holiday{ID: "xx/march", Name: "March 27", Month: time.March, Day: 27, Func: CalcDayOfMonth}
+	old := newYear.Clone(&Holiday{ID: "xx/old", EndYear: 1999})
+	noFunc := &Holiday{Name: "No Func"}
+
+	tests := []struct {
+		hols []*Holiday
+		want []Diagnostic
+	}{
+		{nil, nil},
+		{[]*Holiday{newYear, easter, april}, nil},
+		{[]*Holiday{newYear, old}, nil},
+		{[]*Holiday{newYear, easter, newYear, newYear}, []Diagnostic{
+			{ProblemDuplicateHoliday, newYear, nil, 0, "listed at positions 0 and 2", false},
+			{ProblemDuplicateHoliday, newYear, nil, 0, "listed at positions 0 and 3", false},
+		}},
+		{[]*Holiday{newYear, easter, dup}, []Diagnostic{
+			{ProblemDuplicateDate, newYear, dup, 2020, "occurs on the same date as Duplicate in every year", false},
+		}},
+		{[]*Holiday{newYear, easter, march}, []Diagnostic{
+			{ProblemSameDate, easter, march, 2016, "occurs on the same date as March 27 in 2016", true},
+		}},
+		{[]*Holiday{noFunc, newYear}, []Diagnostic{
+			{ProblemNoFunc, noFunc, nil, 0, "Func is nil", false},
+		}},
+	}
+
+	for i, test := range tests {
+		if got := ValidateHolidays(2015, 2025, test.hols...); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got: %v; want: %v", i, got, test.want)
+		}
+	}
+
+	april4 := &Holiday{ID: "xx/april-4", Name: "April 4", Month: time.April, Day: 4, Func: CalcDayOfMonth}
+	want := []Diagnostic{{ProblemSameDate, april4, easter, 2010, "occurs on the same date as Easter in 2010 and 1 other years", true}}
+	if got := ValidateHolidays(2005, 2025, april4, easter); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	c := &Calendar{}
+	c.AddHoliday(newYear, dup)
+	if got := c.Validate(2015, 2025); len(got) != 1 || got[0].Problem != ProblemDuplicateDate {
+		t.Errorf("got: %v; want duplicate date", got)
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	tests := []struct {
+		d    Diagnostic
+		want string
+	}{
+		{Diagnostic{ProblemNoFunc, &Holiday{ID: "xx/a", Name: "A"}, nil, 0, "Func is nil", false}, "xx/a: no func: Func is nil"},
+		{Diagnostic{ProblemNoFunc, &Holiday{Name: "A"}, nil, 0, "Func is nil", false}, "A: no func: Func is nil"},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.want {
+			t.Errorf("got: %q; want: %q", got, test.want)
+		}
+	}
+
+	names := map[Problem]string{
+		ProblemNoFunc:           "no func",
+		ProblemYearRange:        "year range",
+		ProblemExceptYear:       "except year",
+		ProblemNoDate:           "no date",
+		ProblemObservedMonth:    "observed month",
+		ProblemDuplicateDate:    "duplicate date",
+		ProblemDuplicateHoliday: "duplicate holiday",
+		ProblemSameDate:         "same date",
+		Problem(255):            "unknown",
+	}
+	for p, want := range names {
+		if got := p.String(); got != want {
+			t.Errorf("got: %q; want: %q", got, want)
+		}
+	}
+}
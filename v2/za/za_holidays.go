@@ -18,11 +18,21 @@ var (
 	}
 
 	// NewYear represents New Year's Day on 1-Jan
-	NewYear = aa.NewYear.Clone(&cal.Holiday{Name: "New Year's Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	NewYear = aa.NewYear.Clone(&cal.Holiday{
+		ID:       "za/new-year",
+		Source:   "Public Holidays Act 36 of 1994",
+		Name:     "New Year's Day",
+		Names:    map[string]string{"en": "New Year's Day", "af": "Nuwejaarsdag"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// HumanRightsDay represents Human Rights Day on 21-Mar
 	HumanRightsDay = &cal.Holiday{
+		ID:       "za/human-rights-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "Human Rights Day",
+		Names:    map[string]string{"en": "Human Rights Day", "af": "Menseregtedag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.March,
 		Day:      21,
@@ -31,14 +41,29 @@ var (
 	}
 
 	// GoodFriday represents Good Friday - two days before Easter
-	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{Name: "Good Friday", Type: cal.ObservancePublic})
+	GoodFriday = aa.GoodFriday.Clone(&cal.Holiday{
+		ID:     "za/good-friday",
+		Source: "Public Holidays Act 36 of 1994",
+		Name:   "Good Friday",
+		Names:  map[string]string{"en": "Good Friday", "af": "Goeie Vrydag"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FamilyDay represents Family Day on the Monday after Easter
-	FamilyDay = aa.EasterMonday.Clone(&cal.Holiday{Name: "Family Day", Type: cal.ObservancePublic})
+	FamilyDay = aa.EasterMonday.Clone(&cal.Holiday{
+		ID:     "za/family-day",
+		Source: "Public Holidays Act 36 of 1994",
+		Name:   "Family Day",
+		Names:  map[string]string{"en": "Family Day", "af": "Gesinsdag"},
+		Type:   cal.ObservancePublic,
+	})
 
 	// FreedomDay represents Freedom Day on 27-Apr
 	FreedomDay = &cal.Holiday{
+		ID:       "za/freedom-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "Freedom Day",
+		Names:    map[string]string{"en": "Freedom Day", "af": "Vryheidsdag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.April,
 		Day:      27,
@@ -47,11 +72,21 @@ var (
 	}
 
 	// WorkersDay represents Workers' Day on 1-May
-	WorkersDay = aa.WorkersDay.Clone(&cal.Holiday{Name: "Workers' Day", Type: cal.ObservancePublic, Observed: weekendAlt})
+	WorkersDay = aa.WorkersDay.Clone(&cal.Holiday{
+		ID:       "za/workers-day",
+		Source:   "Public Holidays Act 36 of 1994",
+		Name:     "Workers' Day",
+		Names:    map[string]string{"en": "Workers' Day", "af": "Werkersdag"},
+		Type:     cal.ObservancePublic,
+		Observed: weekendAlt,
+	})
 
 	// YouthDay represents Youth Day on 16-June
 	YouthDay = &cal.Holiday{
+		ID:       "za/youth-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "Youth Day",
+		Names:    map[string]string{"en": "Youth Day", "af": "Jeugdag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.June,
 		Day:      16,
@@ -61,7 +96,10 @@ var (
 
 	// WomensDay represents National Women's Day on 9-Aug
 	WomensDay = &cal.Holiday{
+		ID:       "za/womens-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "National Women's Day",
+		Names:    map[string]string{"en": "National Women's Day", "af": "Nasionale Vrouedag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.August,
 		Day:      9,
@@ -71,7 +109,10 @@ var (
 
 	// HeritageDay represents Heritage Day on 24-Sep
 	HeritageDay = &cal.Holiday{
+		ID:       "za/heritage-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "Heritage Day",
+		Names:    map[string]string{"en": "Heritage Day", "af": "Erfenisdag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.September,
 		Day:      24,
@@ -81,7 +122,10 @@ var (
 
 	// ReconciliationDay represents Day of Reconciliation on 16-Dec
 	ReconciliationDay = &cal.Holiday{
+		ID:       "za/reconciliation-day",
+		Source:   "Public Holidays Act 36 of 1994",
 		Name:     "Reconciliation Day",
+		Names:    map[string]string{"en": "Reconciliation Day", "af": "Versoeningsdag"},
 		Type:     cal.ObservancePublic,
 		Month:    time.December,
 		Day:      16,
@@ -90,10 +134,22 @@ var (
 	}
 
 	// ChristmasDay represents Christmas Day on 25-Dec
-	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{Name: "Christmas Day", Type: cal.ObservanceBank, Observed: weekendAlt})
+	ChristmasDay = aa.ChristmasDay.Clone(&cal.Holiday{
+		ID:       "za/christmas-day",
+		Source:   "Public Holidays Act 36 of 1994",
+		Name:     "Christmas Day",
+		Names:    map[string]string{"en": "Christmas Day", "af": "Kersdag"},
+		Type:     cal.ObservanceBank,
+		Observed: weekendAlt,
+	})
 
 	// GoodwillDay represents Day of Goodwill on 26-Dec
-	GoodwillDay = aa.ChristmasDay2.Clone(&cal.Holiday{Name: "Day of Goodwill", Type: cal.ObservanceBank,
+	GoodwillDay = aa.ChristmasDay2.Clone(&cal.Holiday{
+		ID:     "za/goodwill-day",
+		Source: "Public Holidays Act 36 of 1994",
+		Name:   "Day of Goodwill",
+		Names:  map[string]string{"en": "Day of Goodwill", "af": "Welwillendheidsdag"},
+		Type:   cal.ObservanceBank,
 		Observed: []cal.AltDay{
 			{Day: time.Sunday, Offset: 1},
 			{Day: time.Monday, Offset: 1},
@@ -115,3 +171,12 @@ var (
 		GoodwillDay,
 	}
 )
+
+func init() {
+	cal.Register(&cal.Country{
+		Code:     "ZA",
+		Alpha3:   "ZAF",
+		Name:     "South Africa",
+		Holidays: Holidays,
+	})
+}
@@ -140,3 +140,22 @@ func TestHolidays(t *testing.T) {
 		}
 	}
 }
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		h    *cal.Holiday
+		tag  string
+		want string
+	}{
+		{NewYear, "af", "Nuwejaarsdag"},
+		{GoodwillDay, "af-ZA", "Welwillendheidsdag"},
+		{FreedomDay, "en", "Freedom Day"},
+		{FreedomDay, "zu", "Freedom Day"},
+	}
+
+	for _, test := range tests {
+		if got := test.h.NameIn(test.tag); got != test.want {
+			t.Errorf("%s %s: got: %q; want: %q", test.h.Name, test.tag, got, test.want)
+		}
+	}
+}